@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/nilsocket/def/pkg/db"
+	"github.com/urfave/cli/v2"
+)
+
+var aliasCmd = &cli.Command{
+	Name:      "alias",
+	Usage:     "list aliases, other forms of stored words, ex: ran → run",
+	UsageText: "def alias\n   def alias add alias word\n   def alias rm alias ...",
+	Action:    aliasListAction,
+	Subcommands: []*cli.Command{
+		{
+			Name:         "add",
+			Usage:        "make alias refer to a stored word, ex: a misspelling",
+			UsageText:    "def alias add alias word",
+			Action:       aliasAddAction,
+			BashComplete: completeWords,
+		},
+		{
+			Name:      "rm",
+			Usage:     "remove aliases",
+			UsageText: "def alias rm alias ...",
+			Action:    aliasRmAction,
+		},
+	},
+}
+
+func aliasListAction(c *cli.Context) error {
+	db.Aliases(func(alias, word string) {
+		fmt.Println(alias + " → " + word)
+	})
+	return nil
+}
+
+func aliasAddAction(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	alias, word := c.Args().Get(0), c.Args().Get(1)
+
+	vw, err := db.Get(word)
+	if err != nil {
+		return fmt.Errorf("%s isn't stored: %v", word, err)
+	}
+
+	if db.Has(alias) {
+		return fmt.Errorf("%s is a stored word", alias)
+	}
+
+	return db.PutAlias(alias, vw.Word)
+}
+
+func aliasRmAction(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	for _, alias := range c.Args().Slice() {
+		if err := db.DelAlias(alias); err != nil {
+			log.Println(alias, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/nilsocket/def/pkg/audio"
+	"github.com/nilsocket/def/pkg/db"
+	"github.com/nilsocket/def/pkg/vocab"
+	"github.com/urfave/cli/v2"
+)
+
+var audioOutFlag string
+var audioWavFlag, audioAllFlag bool
+
+var audioCmd = &cli.Command{
+	Name:  "audio",
+	Usage: "pronunciation audio",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "export",
+			Usage:     "write pronunciations of words to files",
+			UsageText: "def audio export [options] word ...",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "out", Aliases: []string{"o"}, Value: ".", Usage: "directory to write files to", Destination: &audioOutFlag},
+				&cli.BoolFlag{Name: "wav", Usage: "transcode to wav", Destination: &audioWavFlag},
+				&cli.BoolFlag{Name: "all", Usage: "export all words in database", Destination: &audioAllFlag},
+			},
+			Action:       audioExportAction,
+			BashComplete: completeWords,
+		},
+		{
+			Name:   "compact",
+			Usage:  "store audios of older words separately, remove unused audios, and report space saved",
+			Action: audioCompactAction,
+		},
+	},
+}
+
+func audioExportAction(c *cli.Context) error {
+	words := c.Args().Slice()
+
+	if audioAllFlag {
+		words = nil
+		db.Iterate(func(key string) {
+			words = append(words, key)
+		})
+	} else if len(words) == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	if err := os.MkdirAll(audioOutFlag, 0755); err != nil {
+		return err
+	}
+
+	for _, word := range words {
+		var vw *vocab.Word
+		if audioAllFlag {
+			vw, _ = db.Get(word)
+		} else {
+			vw = getAndStore(word)
+		}
+
+		if vw == nil {
+			continue
+		}
+
+		if err := db.LoadAudios(vw); err != nil {
+			log.Println("export", vw.Word, err)
+			continue
+		}
+
+		if len(vw.Audios) == 0 {
+			log.Println("no audio for", vw.Word)
+			continue
+		}
+
+		for i, a := range vw.Audios {
+			path := filepath.Join(audioOutFlag, audioFileName(vw.Word, i, len(vw.Audios), a))
+
+			if err := exportAudio(path, a); err != nil {
+				log.Println("export", vw.Word, err)
+				continue
+			}
+
+			fmt.Println(path)
+		}
+	}
+
+	return nil
+}
+
+func audioCompactAction(c *cli.Context) error {
+	stats, err := db.CompactAudios()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("words with audio: %d (%d moved)\n", stats.Words, stats.Moved)
+	fmt.Printf("audios: %d referred, %d stored, %d removed\n", stats.Audios, stats.Unique, stats.Removed)
+	fmt.Printf("size: %s, saved %s\n", byteSize(stats.Stored), byteSize(stats.Saved()))
+
+	return nil
+}
+
+// byteSize ,ex: 2048 => "2.0 KiB"
+func byteSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return strconv.FormatInt(n, 10) + " B"
+	}
+
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// audioFileName ,ex: "ice cream" => "ice_cream.mp3", "ice_cream-2.mp3",
+// synthesized audio is in wav format
+func audioFileName(word string, i, n int, a vocab.Audio) string {
+	name := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '/', '\\':
+			return '_'
+		}
+		return r
+	}, word)
+
+	if n > 1 {
+		name += "-" + strconv.Itoa(i+1)
+	}
+
+	if audioWavFlag || audio.IsWAV(a) {
+		return name + ".wav"
+	}
+	return name + ".mp3"
+}
+
+func exportAudio(path string, a vocab.Audio) error {
+	if !audioWavFlag || audio.IsWAV(a) {
+		return ioutil.WriteFile(path, a, 0644)
+	}
+
+	pcm, sampleRate, err := audio.Decode(a)
+	if err != nil {
+		return err
+	}
+
+	b := &bytes.Buffer{}
+	if err := audio.WriteWAV(b, pcm, sampleRate); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, b.Bytes(), 0644)
+}
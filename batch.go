@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/nilsocket/def/pkg/db"
+	"github.com/nilsocket/def/pkg/vocab"
+)
+
+// batchStats ,summary of batch lookup
+type batchStats struct {
+	Found, Cached, Suggested, Failed int
+}
+
+// batch looks up words read from file, or stdin if file is `-` or empty,
+// stores them in database, and prints status of each word,
+// args are looked up along with words from file
+func batch(file string, args []string) error {
+	var r io.Reader = os.Stdin
+
+	if file != "" && file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	words, err := readWords(r)
+	if err != nil {
+		return err
+	}
+
+	// `-` only indicates stdin
+	if len(args) == 1 && args[0] == "-" {
+		args = nil
+	}
+	words = dedup(append(args, words...))
+
+	stats := &batchStats{}
+	for _, word := range words {
+		batchWord(word, stats)
+	}
+
+	fmt.Printf("\n%d words: %d found, %d cached, %d suggested, %d failed\n",
+		len(words), stats.Found, stats.Cached, stats.Suggested, stats.Failed)
+
+	return nil
+}
+
+// batchWord looks up word, stores it and prints it's status,
+// returns word if found
+func batchWord(word string, stats *batchStats) *vocab.Word {
+	vw, sugs, ldb, err := lookup(word)
+
+	switch {
+	case err != nil:
+		stats.Failed++
+		fmt.Println("failed    " + word + ": " + err.Error())
+	case vw != nil && ldb:
+		stats.Cached++
+		fmt.Println("cached    " + word)
+	case vw != nil:
+		if err := db.Put(vw.Word, vw); err != nil {
+			log.Println("Put", err)
+		}
+		stats.Found++
+		fmt.Println("found     " + word)
+	case len(sugs) != 0:
+		stats.Suggested++
+		fmt.Println("suggested " + word + ": " + strings.Join(sugs, ", "))
+	default:
+		stats.Failed++
+		fmt.Println("failed    " + word)
+	}
+
+	return vw
+}
+
+// readWords reads a word or phrase per line,
+// blank lines and lines starting with `#` are skipped
+func readWords(r io.Reader) ([]string, error) {
+	var words []string
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		words = append(words, line)
+	}
+
+	return words, s.Err()
+}
+
+// dedup words, preserving order
+func dedup(words []string) []string {
+	var uniq []string
+	seen := map[string]bool{}
+
+	for _, word := range words {
+		if !seen[word] {
+			seen[word] = true
+			uniq = append(uniq, word)
+		}
+	}
+
+	return uniq
+}
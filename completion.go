@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nilsocket/def/pkg/db"
+	"github.com/urfave/cli/v2"
+)
+
+var completionCmd = &cli.Command{
+	Name:      "completion",
+	Usage:     "print shell completion script",
+	UsageText: "def completion bash|zsh|fish",
+	Description: `Load completion for current shell:
+   bash: source <(def completion bash)
+   zsh:  source <(def completion zsh)
+   fish: def completion fish | source`,
+	Action: completionAction,
+	BashComplete: func(c *cli.Context) {
+		if c.NArg() == 0 {
+			fmt.Println("bash\nzsh\nfish")
+		}
+	},
+}
+
+func completionAction(c *cli.Context) error {
+	name := c.App.Name
+
+	switch c.Args().First() {
+	case "bash":
+		fmt.Print(strings.ReplaceAll(bashCompletion, "{{name}}", name))
+	case "zsh":
+		fmt.Print(strings.ReplaceAll(zshCompletion, "{{name}}", name))
+	case "fish":
+		static, err := c.App.ToFishCompletion()
+		if err != nil {
+			return err
+		}
+		fmt.Print(static)
+		fmt.Print(strings.ReplaceAll(fishCompletion, "{{name}}", name))
+	default:
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	return nil
+}
+
+// completeWords completes commands and flags as usual,
+// and words stored in database, when we aren't completing a flag
+func completeWords(c *cli.Context) {
+	if c.Command != nil && c.Command.Name != "" {
+		cli.DefaultCompleteWithFlags(c.Command)(c)
+	} else {
+		cli.DefaultAppComplete(c)
+	}
+
+	// shell script marks completion of a flag
+	if os.Getenv(completeFlagEnv) != "" {
+		return
+	}
+
+	// completion of app happens before `Before`,
+	// but completion of commands after it
+	if !db.IsOpen() {
+		db.Open(dbHomeFlag)
+		defer db.Close()
+		db.Lang = langFlag
+	}
+
+	db.Iterate(func(key string) {
+		fmt.Println(key)
+	})
+}
+
+// completeFlagEnv ,set by completion scripts,
+// when current arg is a flag
+const completeFlagEnv = "DEF_COMPLETE_FLAG"
+
+// completion scripts call `def ... --generate-bash-completion`
+// with arguments typed so far, and current arg if it's a flag
+
+var bashCompletion = `_{{name}}_completion() {
+  local cur opts
+  COMPREPLY=()
+  cur="${COMP_WORDS[COMP_CWORD]}"
+  if [[ "$cur" == "-"* ]]; then
+    opts=$( DEF_COMPLETE_FLAG=1 "${COMP_WORDS[@]:0:$COMP_CWORD}" "${cur}" --generate-bash-completion 2>/dev/null )
+  else
+    opts=$( "${COMP_WORDS[@]:0:$COMP_CWORD}" --generate-bash-completion 2>/dev/null )
+  fi
+  local IFS=$'\n'
+  COMPREPLY=( $(compgen -W "${opts}" -- "${cur}") )
+  return 0
+}
+
+complete -o bashdefault -o default -F _{{name}}_completion {{name}}
+`
+
+var zshCompletion = `#compdef {{name}}
+
+_{{name}}_completion() {
+  local -a opts
+  local cur
+  cur=${words[-1]}
+  if [[ "$cur" == "-"* ]]; then
+    opts=("${(@f)$(DEF_COMPLETE_FLAG=1 ${words[@]:0:$#words-1} ${cur} --generate-bash-completion 2>/dev/null)}")
+  else
+    opts=("${(@f)$(${words[@]:0:$#words-1} --generate-bash-completion 2>/dev/null)}")
+  fi
+
+  if [[ "${opts[1]}" != "" ]]; then
+    compadd -a opts
+  fi
+}
+
+compdef _{{name}}_completion {{name}}
+`
+
+var fishCompletion = `
+function __fish_{{name}}_complete
+    set -l args (commandline -opc)
+    $args --generate-bash-completion 2>/dev/null
+end
+
+complete -c {{name}} -f -a '(__fish_{{name}}_complete)'
+`
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/nilsocket/def/pkg/config"
+	"github.com/nilsocket/def/pkg/vocab"
+	"github.com/urfave/cli/v2"
+)
+
+// cfg ,effective configuration,
+// flag defaults are taken from it
+var cfg = loadConfig()
+
+// maxAge of words in database, see cfg.MaxAge
+var maxAge time.Duration
+
+var configCmd = &cli.Command{
+	Name:   "config",
+	Usage:  "print effective configuration",
+	Action: configAction,
+}
+
+func loadConfig() *config.Config {
+	cfg, err := config.Load(config.Path())
+	if err != nil {
+		log.Println("config", err)
+	}
+
+	if cfg.MaxAge != "" {
+		if maxAge, err = config.ParseAge(cfg.MaxAge); err != nil {
+			log.Println("config max_age", err)
+		}
+	}
+
+	vocab.ExampleCount = cfg.Examples
+	vocab.Color = cfg.Color
+
+	return cfg
+}
+
+func configAction(c *cli.Context) error {
+	// flags override config
+	cfg.DBPath = dbHomeFlag
+	cfg.Lang = langFlag
+	if longFlag {
+		cfg.Output = "long"
+	}
+
+	fmt.Println("# " + config.Path())
+	fmt.Print(cfg)
+	return nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"log"
+
+	"github.com/nilsocket/def/pkg/db"
+	"github.com/nilsocket/def/pkg/dict"
+	"github.com/nilsocket/def/pkg/lang"
+	"github.com/nilsocket/def/pkg/vocab"
+	"github.com/urfave/cli/v2"
+)
+
+var dictdAddrFlag string
+
+var dictdCmd = &cli.Command{
+	Name:  "dictd",
+	Usage: "serve stored words over dict protocol (RFC 2229)",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "addr", Value: "localhost:" + dict.DefaultPort, Usage: "address to listen on", Destination: &dictdAddrFlag},
+	},
+	Action: dictdAction,
+}
+
+func dictdAction(c *cli.Context) error {
+	vocab.Color = false
+
+	s := &dict.Server{
+		Database:    "def",
+		Description: "def, words stored offline",
+		Define:      defineStored,
+		Words:       db.Iterate,
+	}
+
+	log.Println("listening on", dictdAddrFlag)
+	return s.ListenAndServe(dictdAddrFlag)
+}
+
+// defineStored returns word from database,
+// word or it's capitalized form in language, nil if neither is stored
+func defineStored(word string) *vocab.Word {
+	if vw, err := db.Get(word); err == nil {
+		return vw
+	}
+
+	if vw, err := db.Get(lang.Title(langFlag, word)); err == nil {
+		return vw
+	}
+
+	return nil
+}
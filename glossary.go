@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/nilsocket/def/pkg/db"
+	"github.com/nilsocket/def/pkg/glossary"
+	"github.com/nilsocket/def/pkg/lang"
+	"github.com/nilsocket/def/pkg/lemma"
+	"github.com/nilsocket/def/pkg/text"
+	"github.com/nilsocket/def/pkg/vocab"
+	"github.com/urfave/cli/v2"
+)
+
+var glossaryFormatFlag string
+
+var glossaryCmd = &cli.Command{
+	Name:      "glossary",
+	Usage:     "print glossary of words in a text file, which are stored offline",
+	UsageText: "def glossary [options] file",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "format", Value: "md", Usage: "md || html", Destination: &glossaryFormatFlag},
+	},
+	Action: glossaryAction,
+}
+
+func glossaryAction(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	f, err := os.Open(c.Args().First())
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	tokens, err := text.Tokenize(f)
+	if err != nil {
+		return err
+	}
+
+	var words []*vocab.Word
+	seen := map[string]bool{}
+
+	for _, t := range tokens {
+		key := storedKey(t.Word)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		vw, err := db.Get(key)
+		if err != nil {
+			continue
+		}
+		words = append(words, vw)
+	}
+
+	glossary.Sort(words)
+
+	switch glossaryFormatFlag {
+	case "md", "markdown":
+		fmt.Print(glossary.Markdown(words))
+	case "html":
+		fmt.Print(glossary.HTML(words))
+	default:
+		return fmt.Errorf("unknown format %q, use md or html", glossaryFormatFlag)
+	}
+
+	return nil
+}
+
+// storedKey returns key of word in database,
+// word, it's base forms, or their capitalized forms are tried,
+// empty if none are stored
+func storedKey(word string) string {
+	candidates := []string{word}
+
+	// lemmatizer is of english only
+	if langFlag == lang.Default {
+		candidates = append(candidates, lemma.Candidates(word)...)
+	}
+
+	for _, c := range candidates {
+		if db.Has(c) {
+			return c
+		}
+	}
+
+	for _, c := range candidates {
+		if capital := lang.Title(langFlag, c); db.Has(capital) {
+			return capital
+		}
+	}
+
+	return ""
+}
@@ -3,7 +3,11 @@ module github.com/nilsocket/def
 go 1.14
 
 require (
+	github.com/BurntSushi/toml v0.4.1
 	github.com/PuerkitoBio/goquery v1.5.1
+	github.com/chzyer/readline v1.5.1
 	github.com/dgraph-io/badger/v2 v2.0.3
+	github.com/hajimehoshi/go-mp3 v0.3.4
 	github.com/urfave/cli/v2 v2.2.0
+	golang.org/x/text v0.14.0
 )
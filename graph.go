@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/nilsocket/def/pkg/db"
+	"github.com/nilsocket/def/pkg/graph"
+	"github.com/nilsocket/def/pkg/vocab"
+	"github.com/urfave/cli/v2"
+)
+
+var graphDepthFlag int
+var graphRelFlag string
+var graphDotFlag, graphOfflineFlag bool
+
+var graphCmd = &cli.Command{
+	Name:      "graph",
+	Usage:     "walk relations of a word, or find path between two words",
+	UsageText: "def graph [options] word [word]",
+	Flags: []cli.Flag{
+		&cli.IntFlag{Name: "depth", Aliases: []string{"d"}, Value: 1, Usage: "depth to walk relations upto", Destination: &graphDepthFlag},
+		&cli.StringFlag{Name: "rel", Value: "synonyms,antonyms,type-of", Usage: "relations to walk, comma separated", Destination: &graphRelFlag},
+		&cli.BoolFlag{Name: "dot", Usage: "print graph in graphviz dot format", Destination: &graphDotFlag},
+		&cli.BoolFlag{Name: "offline", Aliases: []string{"o"}, Usage: "don't fetch words missing in database", Destination: &graphOfflineFlag},
+	},
+	Action:       graphAction,
+	BashComplete: completeWords,
+}
+
+func graphAction(c *cli.Context) error {
+	words := c.Args().Slice()
+
+	if len(words) == 0 || len(words) > 2 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	// words missing in database aren't fetched
+	if graphOfflineFlag {
+		cfg.Providers = nil
+	}
+
+	g := graph.Build(words[0], graphDepthFlag, strings.Split(graphRelFlag, ","), graphNode)
+
+	switch {
+	case len(words) == 2:
+		path := g.PathTo(words[1])
+		if path == nil {
+			fmt.Printf("no path from %s to %s within depth %d\n", words[0], words[1], graphDepthFlag)
+			return nil
+		}
+		fmt.Print(graph.SprintPath(path))
+	case graphDotFlag:
+		fmt.Print(g.DOT())
+	default:
+		fmt.Print(g.Sprint())
+	}
+
+	return nil
+}
+
+// graphNode returns word from database,
+// if not found, fetches and stores it unless offline
+func graphNode(word string) *vocab.Word {
+	vw, _, ldb, err := lookup(word)
+	if err != nil {
+		log.Println(word, err)
+		return nil
+	}
+
+	if vw != nil && !ldb {
+		if err := db.Put(vw.Word, vw); err != nil {
+			log.Println("Put", err)
+		}
+	}
+
+	return vw
+}
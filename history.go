@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/nilsocket/def/pkg/db"
+	"github.com/nilsocket/def/pkg/vocab"
+	"github.com/urfave/cli/v2"
+)
+
+var historyCmd = &cli.Command{
+	Name:         "history",
+	Usage:        "print how a word changed, across refreshes",
+	UsageText:    "def history word ...",
+	Action:       historyAction,
+	BashComplete: completeWords,
+}
+
+func historyAction(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	for _, word := range c.Args().Slice() {
+		current, err := db.Get(word)
+		if err != nil {
+			fmt.Println(word+":", err)
+			continue
+		}
+
+		versions, err := db.Versions(current.Word)
+		if err != nil {
+			return err
+		}
+
+		if len(versions) == 0 {
+			fmt.Println(current.Word+": no previous versions, fetched", fetchedAt(current))
+			continue
+		}
+
+		versions = append(versions, current)
+
+		fmt.Println(current.Word + ":")
+		for i := 1; i < len(versions); i++ {
+			prev, next := versions[i-1], versions[i]
+
+			fmt.Println(vocab.Indent + fetchedAt(prev) + " → " + fetchedAt(next))
+			for _, change := range vocab.Diff(prev, next) {
+				fmt.Println(vocab.Indent + vocab.Indent + change)
+			}
+		}
+	}
+
+	return nil
+}
+
+func fetchedAt(w *vocab.Word) string {
+	if w.Fetched.IsZero() {
+		return "unknown"
+	}
+	return w.Fetched.Format("2006-01-02 15:04")
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/nilsocket/def/pkg/db"
+	"github.com/nilsocket/def/pkg/lang"
+	"github.com/nilsocket/def/pkg/vocab"
+	"github.com/nilsocket/def/pkg/wiktionary"
+	"github.com/urfave/cli/v2"
+)
+
+var importLangFlag string
+
+var importCmd = &cli.Command{
+	Name:  "import",
+	Usage: "import dictionary dumps, used by offline providers",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "wiktionary",
+			Usage:     "import wiktionary JSON lines dump, as extracted by kaikki.org, replacing previous import",
+			UsageText: "def import wiktionary [options] file.jsonl[.gz]\n   curl ... | def import wiktionary -",
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "lang", Usage: "language code of entries to import, defaults to global --lang", Destination: &importLangFlag},
+			},
+			Action: importWiktionaryAction,
+		},
+	},
+}
+
+// wiktionarySource ,source of imported wiktionary words
+func wiktionarySource(lang string) string {
+	return "wiktionary/" + lang
+}
+
+func importWiktionaryAction(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	// words are looked up in a language
+	if importLangFlag == "" {
+		importLangFlag = langFlag
+	} else if !lang.Known(importLangFlag) {
+		return fmt.Errorf("unknown language %q", importLangFlag)
+	}
+
+	r, err := openDump(c.Args().First())
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	im, err := db.NewImporter(wiktionarySource(importLangFlag))
+	if err != nil {
+		return err
+	}
+
+	err = wiktionary.Decode(r, importLangFlag, func(w *vocab.Word) error {
+		// entries of word weren't consecutive
+		if prev, err := im.Get(w.Word); err == nil {
+			w = wiktionary.Merge(prev, w)
+		} else if err != db.ErrKeyNotFound {
+			return err
+		}
+
+		return im.Put(w)
+	})
+	if err != nil {
+		im.Cancel()
+		return fmt.Errorf("import stopped after %d words: %v", im.Count, err)
+	}
+
+	// don't replace previous import with nothing
+	if im.Count == 0 {
+		im.Cancel()
+		return fmt.Errorf("no words of language %q found, see --lang", importLangFlag)
+	}
+
+	if err := im.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Println("imported", im.Count, "words")
+	return nil
+}
+
+// openDump opens file, or stdin if it's "-",
+// gzipped files are decompressed while reading
+func openDump(file string) (io.ReadCloser, error) {
+	var f io.ReadCloser = os.Stdin
+	if file != "-" {
+		var err error
+		if f, err = os.Open(file); err != nil {
+			return nil, err
+		}
+	}
+
+	if !strings.HasSuffix(file, ".gz") {
+		return f, nil
+	}
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{gz, f}, nil
+}
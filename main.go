@@ -1,33 +1,42 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
-	"path/filepath"
 	"strings"
 
+	"github.com/nilsocket/def/pkg/audio"
 	"github.com/nilsocket/def/pkg/db"
+	"github.com/nilsocket/def/pkg/lang"
+	"github.com/nilsocket/def/pkg/lemma"
 	"github.com/nilsocket/def/pkg/vocab"
 	"github.com/urfave/cli/v2"
 )
 
-var longFlag, synFlag, antFlag, playFlag, rmFlag, cleanDBFlag bool
-var dbHomeFlag string
+var longFlag, synFlag, antFlag, playFlag, rmFlag, cleanDBFlag, listRelFlag, interactiveFlag, refreshFlag bool
+var dbHomeFlag, relFlag, fileFlag, langFlag string
 
 var homeDir, _ = os.UserHomeDir()
 
 var def = &cli.App{
 	Name:      "def",
 	Usage:     "find definition",
-	UsageText: "def [options] [word ...]",
+	UsageText: "def [options] [word ...]\n   def [options] -f file\n   cat file | def [options] -",
 	Flags: []cli.Flag{
 		&cli.BoolFlag{Name: "long", Aliases: []string{"l"}, Usage: "print definition in long format", Destination: &longFlag},
 		&cli.BoolFlag{Name: "synonyms", Aliases: []string{"s"}, Usage: "print definitions followed by list of synonms", Destination: &synFlag},
 		&cli.BoolFlag{Name: "antonyms", Aliases: []string{"a"}, Usage: "print definitions followed by list of antonyms", Destination: &antFlag},
+		&cli.StringFlag{Name: "rel", Usage: "print definitions followed by given relations, comma separated (ex: type-of,types)", Destination: &relFlag},
+		&cli.BoolFlag{Name: "listRel", Usage: "list relation types available for word", Destination: &listRelFlag},
+		&cli.StringFlag{Name: "file", Aliases: []string{"f"}, Usage: "read words from file, one per line, `-` for stdin", Destination: &fileFlag},
 		&cli.BoolFlag{Name: "playAudio", Aliases: []string{"p"}, Usage: "play audio, if avialable", Destination: &playFlag},
+		&cli.BoolFlag{Name: "refresh", Usage: "refetch word, even if it's in database", Destination: &refreshFlag},
 		&cli.BoolFlag{Name: "rm", Aliases: []string{"r"}, Usage: "remove word from database", Destination: &rmFlag},
-		&cli.StringFlag{Name: "dbPath", Aliases: []string{"path"}, Value: filepath.Join(homeDir, ".def"), Usage: "path to local database", Destination: &dbHomeFlag},
+		&cli.StringFlag{Name: "lang", Value: cfg.Lang, Usage: "language of words, ex: de, words of each language are stored separately", Destination: &langFlag},
+		&cli.StringFlag{Name: "dbPath", Aliases: []string{"path"}, Value: cfg.DBPath, Usage: "path to local database", Destination: &dbHomeFlag},
+		&cli.BoolFlag{Name: "interactive", Aliases: []string{"i"}, Usage: "interactive mode, keeps database open between lookups", Destination: &interactiveFlag},
 		&cli.BoolFlag{Name: "cleanDB", Usage: "clean/remove local database", Destination: &cleanDBFlag},
 	},
 	Before: func(c *cli.Context) error {
@@ -35,19 +44,29 @@ var def = &cli.App{
 			os.RemoveAll(dbHomeFlag)
 		}
 
+		if !lang.Known(langFlag) {
+			return fmt.Errorf("unknown language %q, use a two letter code, ex: de", langFlag)
+		}
+
 		db.Open(dbHomeFlag)
+		db.Lang = langFlag
 		return nil
 	},
-	Action: defAction,
+	Action:   defAction,
+	Commands: []*cli.Command{graphCmd, scanCmd, glossaryCmd, serveCmd, dictdCmd, importCmd, aliasCmd, audioCmd, refreshCmd, historyCmd, completionCmd, configCmd},
 	After: func(c *cli.Context) error {
 		db.Close()
 		return nil
 	},
 	UseShortOptionHandling: true,
+	EnableBashCompletion:   true,
+	BashComplete:           completeWords,
 }
 
 func main() {
-	def.Run(os.Args)
+	if err := def.Run(os.Args); err != nil {
+		log.Println(err)
+	}
 }
 
 func defAction(c *cli.Context) error {
@@ -55,6 +74,14 @@ func defAction(c *cli.Context) error {
 
 	words = c.Args().Slice()
 
+	if interactiveFlag {
+		return repl()
+	}
+
+	if fileFlag != "" || (len(words) == 1 && words[0] == "-") {
+		return batch(fileFlag, words)
+	}
+
 	// if only `def` is typed, then iterate
 	// existing words
 	if len(words) == 0 {
@@ -63,31 +90,65 @@ func defAction(c *cli.Context) error {
 		})
 	} else {
 		for _, word := range words {
+			defWord(word)
+		}
+	}
 
-			vw, ldb := get(word)
+	return nil
+}
 
-			if vw != nil && !ldb {
-				if err := db.Put(vw.Word, vw); err != nil {
-					log.Println("Put", err)
-				}
-			}
+// defWord gets word, stores it in db if necessary,
+// and prints or removes it, as per flags
+func defWord(word string) {
+	vw := getAndStore(word)
 
-			if rmFlag {
-				if err := db.Del(vw.Word); err != nil {
-					log.Println("Del", err)
-				}
-				// don't print anything
-				// while deleting a word
-				vw = nil
-			}
+	if rmFlag && vw != nil {
+		if err := remove(word, vw); err != nil {
+			log.Println("rm", err)
+		}
+		// don't print anything
+		// while deleting a word
+		vw = nil
+	}
 
-			if vw != nil {
-				printWord(vw)
-			}
+	if vw != nil {
+		printWord(vw)
+	}
+}
+
+// remove word, vw is what word resolved to,
+// if word is an alias of vw, only alias is removed,
+// ex: `def -r news` shouldn't remove `new`
+func remove(word string, vw *vocab.Word) error {
+	word = lang.Clean(word)
+
+	if lang.Fold(langFlag, word) == lang.Fold(langFlag, vw.Word) {
+		return db.Del(vw.Word)
+	}
+
+	if target, err := db.Alias(word); err == nil && target == vw.Word {
+		return db.DelAlias(word)
+	}
+
+	return fmt.Errorf("%w, %s is %s", errNotStored, word, vw.Word)
+}
+
+// errNotStored ,word resolves to a stored word,
+// but neither it nor it's alias is stored
+var errNotStored = errors.New("only stored words and aliases are removed")
+
+// getAndStore gets word, and stores it in db,
+// if it isn't from db
+func getAndStore(word string) *vocab.Word {
+	vw, ldb := get(word)
+
+	if vw != nil && !ldb {
+		if err := db.Put(vw.Word, vw); err != nil {
+			log.Println("Put", err)
 		}
 	}
 
-	return nil
+	return vw
 }
 
 func printWord(w *vocab.Word) {
@@ -100,14 +161,67 @@ func printWord(w *vocab.Word) {
 	if antFlag {
 		fmt.Print(w.SprintAnt())
 	}
+	if relFlag != "" {
+		fmt.Print(w.SprintRel(strings.Split(relFlag, ",")))
+	}
+	if listRelFlag {
+		fmt.Print(w.SprintRelTypes())
+	}
 	if playFlag {
-		w.PlayAudio()
+		playAudio(w)
+	}
+
+	if !longFlag && !synFlag && !antFlag && relFlag == "" && !listRelFlag {
+		if cfg.Output == "long" {
+			fmt.Print(w.Sprintl())
+		} else {
+			fmt.Print(w.Sprints())
+		}
+	}
+
+}
+
+func playAudio(w *vocab.Word) {
+	if err := db.LoadAudios(w); err != nil {
+		log.Println("play", err)
+		return
+	}
+
+	// no recorded audio
+	if len(w.Audios) == 0 {
+		if err := synthesizeAudio(w); err != nil {
+			log.Println("play", w.Word, "has no audio, tts:", err)
+			return
+		}
+	}
+
+	if w.Synthetic {
+		fmt.Println("(synthesized pronunciation)")
+	}
+
+	player, err := audio.New(cfg.Player, cfg.Sink)
+	if err != nil {
+		log.Println("play", err)
+		return
+	}
+
+	if err := w.PlayAudio(player); err != nil {
+		log.Println("play", err)
 	}
+}
 
-	if !longFlag && !synFlag && !antFlag {
-		fmt.Print(w.Sprints())
+// synthesizeAudio of word using tts command,
+// and store it along with word
+func synthesizeAudio(w *vocab.Word) error {
+	a, err := audio.Synthesize(cfg.TTS, w.Word)
+	if err != nil {
+		return err
 	}
 
+	w.Audios = []vocab.Audio{a}
+	w.Synthetic = true
+
+	return db.Put(w.Word, w)
 }
 
 // get word from either database or from internet
@@ -140,29 +254,92 @@ func get(word string) (*vocab.Word, bool) {
 	//     }
 	// }
 
-	vw, sugs, ldb := fetchFromDBorInternet(word)
+	vw, sugs, ldb, err := lookup(word)
+	if err != nil {
+		log.Println(word, err)
+		return nil, false
+	}
+
+	if vw == nil {
+		fmt.Print(vocab.SprintSuggestions(sugs))
+		misspelled.word, misspelled.sugs = word, sugs
+		return nil, false
+	}
+
+	if lang.Fold(langFlag, word) != lang.Fold(langFlag, vw.Word) {
+		fmt.Println(word + " → " + vw.Word) // ex: ran → run
+	}
+
+	// user picked a suggestion of previous word
+	for _, s := range misspelled.sugs {
+		if s == vw.Word {
+			rememberAlias(misspelled.word, vw)
+		}
+	}
+	misspelled.word, misspelled.sugs = "", nil
+
+	return vw, ldb
+}
+
+// misspelled ,last word which wasn't found, and it's suggestions,
+// if one of them is looked up next, ex: in interactive mode,
+// misspelled word is remembered as it's alias
+var misspelled struct {
+	word string
+	sugs []string
+}
+
+// lookup is same as get, except that
+// suggestions and errors are returned instead of printing them
+func lookup(word string) (*vocab.Word, []string, bool, error) {
+	word = lang.Clean(word)
+
+	vw, sugs, ldb, err := fetchFromDBorInternet(word)
+	if err != nil {
+		return nil, nil, false, err
+	}
 
 	if len(sugs) == 0 && vw != nil {
-		return vw, ldb
+		return vw, nil, ldb, nil
 	}
 
 	if capitalizedWord(word, sugs) {
 		// if we got suggestion as capitalized word,
 		// then it is capital only
 
-		vw, _, ldb := fetchFromDBorInternet(sugs[0])
+		vw, _, ldb, err := fetchFromDBorInternet(sugs[0])
+		if err != nil {
+			return nil, nil, false, err
+		}
 
-		if !vw.CapitalOnly {
+		if vw != nil && !vw.CapitalOnly {
 			vw.CapitalOnly = true
 			ldb = false
 		}
 
-		return vw, ldb
+		rememberAlias(word, vw)
+		return vw, nil, ldb, nil
 	}
 
-	fmt.Print(vocab.SprintSuggestions(sugs))
+	return nil, sugs, false, nil
+}
 
-	return nil, false
+// rememberAlias of word, if it resolved to a different word,
+// so that next lookup doesn't go through providers,
+// ex: `bharat` => `Bharat`, `ran` => `run`
+func rememberAlias(word string, vw *vocab.Word) {
+	if vw == nil || vw.Word == lang.Clean(word) || db.Has(word) {
+		return
+	}
+
+	// already remembered
+	if target, err := db.Alias(word); err == nil && target == vw.Word {
+		return
+	}
+
+	if err := db.PutAlias(word, vw.Word); err != nil {
+		log.Println("alias", err)
+	}
 }
 
 // If found in DB {
@@ -170,30 +347,138 @@ func get(word string) (*vocab.Word, bool) {
 // } else {
 //     return from Internet
 // }
-func fetchFromDBorInternet(word string) (*vocab.Word, []string, bool) {
+func fetchFromDBorInternet(word string) (*vocab.Word, []string, bool, error) {
 
 	vw, err := db.Get(word)                     // ex: `bharat`
 	if err != nil && err == db.ErrKeyNotFound { // not found
-		capitalWord := strings.Title(strings.ToLower(word))
 
-		vw, err := db.Get(capitalWord) // search for `Bharat`s
+		if vw := storedAlias(word); vw != nil { // ex: `bharat` => `Bharat`
+			vw, ldb := refetchIfStale(vw)
+			return vw, nil, ldb, nil
+		}
 
-		if err != nil && err == db.ErrKeyNotFound {
-			vw, sugs := vocab.Get(word) // return from Internet
-			return vw, sugs, false
-		} else if vw.CapitalOnly { // if it is Bharat only, return
-			return vw, nil, true
-		} else {
-			vw, sugs := vocab.Get(word) // return from Internet
-			return vw, sugs, false
+		if vw := storedVariant(word); vw != nil { // ex: `Bharat`, `café`
+			rememberAlias(word, vw)
+			vw, ldb := refetchIfStale(vw)
+			return vw, nil, ldb, nil
+		}
+
+		vw, sugs, answered := fetchFromProviders(word) // return from Internet
+		if vw != nil {
+			rememberAlias(word, vw)
+			return vw, nil, false, nil
+		}
+
+		if len(sugs) != 0 {
+			return nil, sugs, false, nil
+		}
+
+		// base form is trusted only if word isn't a headword,
+		// ex: `walked` => `walk`, `ran` => `run`,
+		// but not `news` => `new`, `bit` => `bite`
+		if vw := storedLemma(word); vw != nil {
+			if answered {
+				rememberAlias(word, vw)
+			}
+			vw, ldb := refetchIfStale(vw)
+			return vw, nil, ldb, nil
 		}
 
+		return nil, db.Variants(word), false, nil // ex: `Divine`, when only `divine` is stored
+
 	} else if err != nil {
-		log.Fatalln(err)
-		// we will exit here
+		return nil, nil, false, err
+	}
+
+	vw, ldb := refetchIfStale(vw)
+	return vw, nil, ldb, nil
+}
+
+// storedWord returns stored word, word refers to,
+// looking only in database and aliases, nil if none
+func storedWord(word string) *vocab.Word {
+	word = lang.Clean(word)
+
+	if vw, err := db.Get(word); err == nil {
+		return vw
+	}
+
+	if vw := storedAlias(word); vw != nil {
+		return vw
+	}
+
+	return storedVariant(word)
+}
+
+// storedVariant returns stored word written differently,
+// nil if none.
+// Variants differing in diacritics, hyphens or spacing are same word,
+// ex: `cafe`, `café`
+// but variants differing in case are same word only if
+// it is capital only, ex: `bharat`, `Bharat`
+// since capitonyms are different words, ex: `divine`, `Divine`
+func storedVariant(word string) *vocab.Word {
+	variants := db.Variants(word)
+
+	for _, v := range variants {
+		if unmarked(v) == unmarked(word) {
+			if vw, err := db.Get(v); err == nil {
+				return vw
+			}
+		}
+	}
+
+	for _, v := range variants {
+		if vw, err := db.Get(v); err == nil && vw.CapitalOnly {
+			return vw
+		}
+	}
+
+	return nil
+}
+
+// storedAlias returns stored word, word is an alias of,
+// nil if it isn't an alias
+func storedAlias(word string) *vocab.Word {
+	target, err := db.Alias(word)
+	if err != nil {
+		return nil
+	}
+
+	vw, err := db.Get(target)
+	if err != nil {
+		return nil
+	}
+
+	return vw
+}
+
+// storedLemma returns stored base form of
+// an english word, nil if none,
+// irregular forms may be words themselves, ex: `bit`,
+// and base forms of regular words are guesses
+func storedLemma(word string) *vocab.Word {
+	if langFlag != lang.Default {
+		return nil
 	}
 
-	return vw, nil, true
+	for _, c := range lemma.Candidates(word) {
+		if c == lang.Lower(langFlag, word) {
+			continue
+		}
+
+		if vw, err := db.Get(c); err == nil {
+			return vw
+		}
+	}
+
+	return nil
+}
+
+// unmarked ,word without diacritics, and hyphens as spaces,
+// case is kept
+func unmarked(word string) string {
+	return strings.ReplaceAll(lang.StripMarks(lang.Clean(word)), "-", " ")
 }
 
 // https://en.wikipedia.org/wiki/Capitonym
@@ -201,7 +486,7 @@ func fetchFromDBorInternet(word string) (*vocab.Word, []string, bool) {
 // Incase of `bharat`, `Bharat` is returned as suggestion
 func capitalizedWord(word string, sugs []string) bool {
 	if len(sugs) == 1 {
-		return strings.ToLower(sugs[0]) == word
+		return lang.Lower(langFlag, sugs[0]) == word
 	}
 	return false
 }
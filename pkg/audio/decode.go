@@ -0,0 +1,132 @@
+package audio
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/go-mp3"
+)
+
+// Channels and BitDepth of decoded PCM,
+// samples are signed 16 bit little endian, interleaved
+const (
+	Channels = 2
+	BitDepth = 16
+)
+
+// Decode mp3 to PCM,
+// WAV is accepted too, see ReadWAV
+func Decode(mp3Data []byte) (pcm []byte, sampleRate int, err error) {
+	if IsWAV(mp3Data) {
+		return ReadWAV(mp3Data)
+	}
+
+	d, err := mp3.NewDecoder(bytes.NewReader(mp3Data))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	pcm, err = ioutil.ReadAll(d)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return pcm, d.SampleRate(), nil
+}
+
+// Sink receives decoded PCM
+type Sink interface {
+	Open(sampleRate int) (io.WriteCloser, error)
+}
+
+// GoPlayer decodes mp3 in Go and writes PCM to Sink,
+// wav is played too
+type GoPlayer struct {
+	Sink Sink
+}
+
+// Play implements Player
+func (p *GoPlayer) Play(mp3 []byte) error {
+	decode := Decode
+	if IsWAV(mp3) {
+		decode = ReadWAV
+	}
+
+	pcm, sampleRate, err := decode(mp3)
+	if err != nil {
+		return err
+	}
+
+	w, err := p.Sink.Open(sampleRate)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(pcm); err != nil {
+		w.Close()
+		return err
+	}
+
+	return w.Close()
+}
+
+// CmdSink writes PCM to stdin of an external command,
+// `{rate}` in args is replaced by sample rate,
+// ex: "aplay -q -f S16_LE -c 2 -r {rate}"
+type CmdSink struct {
+	Name string
+	Args []string
+}
+
+// NewCmdSink returns CmdSink for command,
+// error if command isn't found in PATH
+func NewCmdSink(command string) (*CmdSink, error) {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return nil, ErrNoPlayer
+	}
+
+	if _, err := exec.LookPath(fields[0]); err != nil {
+		return nil, err
+	}
+
+	return &CmdSink{Name: fields[0], Args: fields[1:]}, nil
+}
+
+// Open implements Sink
+func (s *CmdSink) Open(sampleRate int) (io.WriteCloser, error) {
+	args := make([]string, len(s.Args))
+	for i, arg := range s.Args {
+		args[i] = strings.ReplaceAll(arg, "{rate}", strconv.Itoa(sampleRate))
+	}
+
+	cmd := exec.Command(s.Name, args...)
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	return &cmdWriter{stdin, cmd}, nil
+}
+
+// cmdWriter waits for command to exit on Close
+type cmdWriter struct {
+	io.WriteCloser
+	cmd *exec.Cmd
+}
+
+func (w *cmdWriter) Close() error {
+	if err := w.WriteCloser.Close(); err != nil {
+		return err
+	}
+	return w.cmd.Wait()
+}
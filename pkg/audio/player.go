@@ -0,0 +1,175 @@
+package audio
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Player plays mp3 audio, or wav
+type Player interface {
+	Play(mp3 []byte) error
+}
+
+// ErrNoPlayer is returned when no audio player is available
+var ErrNoPlayer = errors.New("no audio player found, install one of mpg123, mpv, ffplay, afplay, aplay, or set player in config")
+
+// Presets ,commands of known players,
+// `{}` is replaced by path of a temporary audio file,
+// otherwise audio is written to stdin
+var Presets = map[string]string{
+	"mpg123": "mpg123 -q -",
+	"mpv":    "mpv --no-video --really-quiet -",
+	"ffplay": "ffplay -nodisp -autoexit -loglevel quiet -",
+	"afplay": "afplay {}",
+}
+
+// presetOrder ,order in which presets are tried
+var presetOrder = []string{"mpg123", "mpv", "ffplay", "afplay"}
+
+// wavPresetOrder ,order in which presets playing wav are tried
+var wavPresetOrder = []string{"mpv", "ffplay", "afplay"}
+
+// New returns player for given spec:
+//   - "auto", first available preset, then pure-Go decoder writing to sink,
+//     wav (ex: synthesized) is played by pure-Go decoder, or a preset playing it
+//   - "go", pure-Go decoder writing to sink
+//   - preset name, ex: "mpv"
+//   - command, ex: "mpg123 -"
+//
+// sink is a command reading raw PCM from stdin,
+// see CmdSink
+func New(spec, sink string) (Player, error) {
+	switch spec {
+	case "auto", "":
+		a := &Auto{}
+
+		if s, err := NewCmdSink(sink); err == nil {
+			a.MP3 = &GoPlayer{Sink: s}
+			a.WAV = a.MP3
+		}
+
+		for _, name := range presetOrder {
+			if p, err := NewCmd(Presets[name]); err == nil {
+				a.MP3 = p
+				break
+			}
+		}
+
+		for _, name := range wavPresetOrder {
+			if a.WAV != nil {
+				break
+			}
+			if p, err := NewCmd(Presets[name]); err == nil {
+				a.WAV = p
+			}
+		}
+
+		if a.MP3 == nil {
+			return nil, ErrNoPlayer
+		}
+
+		return a, nil
+	case "go":
+		s, err := NewCmdSink(sink)
+		if err != nil {
+			return nil, err
+		}
+		return &GoPlayer{Sink: s}, nil
+	}
+
+	if preset, ok := Presets[spec]; ok {
+		spec = preset
+	}
+
+	return NewCmd(spec)
+}
+
+// Auto plays mp3 using MP3, and wav using WAV,
+// since not every player can play wav, ex: mpg123
+type Auto struct {
+	MP3 Player
+	WAV Player // MP3 is used, if nil
+}
+
+// Play implements Player
+func (a *Auto) Play(mp3 []byte) error {
+	if IsWAV(mp3) && a.WAV != nil {
+		return a.WAV.Play(mp3)
+	}
+
+	return a.MP3.Play(mp3)
+}
+
+// Cmd plays audio using an external command
+type Cmd struct {
+	Name string
+	Args []string
+}
+
+// NewCmd returns Cmd for command,
+// error if command isn't found in PATH
+func NewCmd(command string) (*Cmd, error) {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return nil, ErrNoPlayer
+	}
+
+	if _, err := exec.LookPath(fields[0]); err != nil {
+		return nil, err
+	}
+
+	return &Cmd{Name: fields[0], Args: fields[1:]}, nil
+}
+
+// Play implements Player
+func (c *Cmd) Play(mp3 []byte) error {
+	args := make([]string, len(c.Args))
+	copy(args, c.Args)
+
+	// players which can't read from stdin
+	fileArg := false
+	for i, arg := range args {
+		if arg == "{}" {
+			fileArg = true
+			path, err := tempAudio(mp3)
+			if err != nil {
+				return err
+			}
+			defer os.Remove(path)
+			args[i] = path
+		}
+	}
+
+	cmd := exec.Command(c.Name, args...)
+	if !fileArg {
+		cmd.Stdin = bytes.NewReader(mp3)
+	}
+
+	return cmd.Run()
+}
+
+// tempAudio writes audio to a temporary file,
+// named by it's format
+func tempAudio(mp3 []byte) (string, error) {
+	pattern := "def-*.mp3"
+	if IsWAV(mp3) {
+		pattern = "def-*.wav"
+	}
+
+	f, err := ioutil.TempFile("", pattern)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := f.Write(mp3); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+
+	return f.Name(), nil
+}
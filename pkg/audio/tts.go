@@ -0,0 +1,37 @@
+package audio
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+// Synthesize speaks word using a text to speech command,
+// `{word}` in args is replaced by word, audio is read from stdout,
+// ex: "espeak-ng --stdout {word}"
+func Synthesize(command, word string) ([]byte, error) {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return nil, errors.New("tts command isn't set")
+	}
+
+	args := make([]string, len(fields)-1)
+	for i, arg := range fields[1:] {
+		args[i] = strings.ReplaceAll(arg, "{word}", word)
+	}
+
+	b := &bytes.Buffer{}
+
+	cmd := exec.Command(fields[0], args...)
+	cmd.Stdout = b
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+
+	if b.Len() == 0 {
+		return nil, errors.New("tts command produced no audio")
+	}
+
+	return b.Bytes(), nil
+}
@@ -0,0 +1,102 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// IsWAV reports whether audio is in WAV format
+func IsWAV(a []byte) bool {
+	return len(a) > 12 && bytes.Equal(a[:4], []byte("RIFF")) && bytes.Equal(a[8:12], []byte("WAVE"))
+}
+
+// ReadWAV returns PCM of 16 bit WAV, in the format returned by Decode,
+// mono is converted to stereo
+func ReadWAV(wav []byte) (pcm []byte, sampleRate int, err error) {
+	if !IsWAV(wav) {
+		return nil, 0, errors.New("not a wav file")
+	}
+
+	var channels, bitDepth int
+
+	// chunks follow RIFF header
+	for b := wav[12:]; len(b) >= 8; {
+		id := string(b[:4])
+		size := int(binary.LittleEndian.Uint32(b[4:8]))
+		b = b[8:]
+
+		// streamed wav (espeak-ng --stdout) has unknown data size
+		if size > len(b) {
+			size = len(b)
+		}
+
+		switch id {
+		case "fmt ":
+			if size < 16 {
+				return nil, 0, errors.New("invalid wav format chunk")
+			}
+			channels = int(binary.LittleEndian.Uint16(b[2:4]))
+			sampleRate = int(binary.LittleEndian.Uint32(b[4:8]))
+			bitDepth = int(binary.LittleEndian.Uint16(b[14:16]))
+		case "data":
+			pcm = b[:size]
+		}
+
+		// chunks are word aligned
+		if size%2 == 1 && size < len(b) {
+			size++
+		}
+		b = b[size:]
+	}
+
+	if pcm == nil || bitDepth != BitDepth || (channels != 1 && channels != Channels) {
+		return nil, 0, errors.New("unsupported wav, only 16 bit mono or stereo is supported")
+	}
+
+	if channels == 1 {
+		stereo := make([]byte, 0, len(pcm)*2)
+		for i := 0; i+1 < len(pcm); i += 2 {
+			stereo = append(stereo, pcm[i], pcm[i+1], pcm[i], pcm[i+1])
+		}
+		pcm = stereo
+	}
+
+	return pcm, sampleRate, nil
+}
+
+// WriteWAV writes PCM, as returned by Decode,
+// in WAV format to w
+func WriteWAV(w io.Writer, pcm []byte, sampleRate int) error {
+	blockAlign := Channels * BitDepth / 8
+
+	header := []interface{}{
+		[4]byte{'R', 'I', 'F', 'F'},
+		uint32(36 + len(pcm)),
+		[4]byte{'W', 'A', 'V', 'E'},
+
+		// format chunk
+		[4]byte{'f', 'm', 't', ' '},
+		uint32(16), // chunk size
+		uint16(1),  // PCM
+		uint16(Channels),
+		uint32(sampleRate),
+		uint32(sampleRate * blockAlign), // byte rate
+		uint16(blockAlign),
+		uint16(BitDepth),
+
+		// data chunk
+		[4]byte{'d', 'a', 't', 'a'},
+		uint32(len(pcm)),
+	}
+
+	for _, v := range header {
+		if err := binary.Write(w, binary.LittleEndian, v); err != nil {
+			return err
+		}
+	}
+
+	_, err := w.Write(pcm)
+	return err
+}
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Config ,settings of def,
+// flags take precedence over config
+type Config struct {
+	Output    string   `toml:"output"`    // short || long
+	Lang      string   `toml:"lang"`      // language of words, ex: en, de
+	DBPath    string   `toml:"db_path"`   // path to local database
+	Player    string   `toml:"player"`    // auto || go || mpg123 || mpv || ffplay || afplay || command
+	Sink      string   `toml:"sink"`      // command reading raw PCM from stdin, used by go player
+	TTS       string   `toml:"tts"`       // text to speech command, used if word has no audio, empty to disable
+	Examples  int      `toml:"examples"`  // examples printed in short format
+	Color     bool     `toml:"color"`     // colored output
+	Providers []string `toml:"providers"` // providers tried in order, if not found in database
+	Offline   bool     `toml:"offline"`   // skip network providers, use only local database and offline providers
+	MaxAge    string   `toml:"max_age"`   // refetch words older than it, ex: "180d", empty to never refetch
+
+	DictServer   string `toml:"dict_server"`   // DICT server used by dict provider, host[:port]
+	DictDatabase string `toml:"dict_database"` // DICT database to search, "*" for all, "!" for first match
+
+	StarDict []string `toml:"stardict"` // StarDict .ifo files or directories, used by stardict provider
+	WordNet  string   `toml:"wordnet"`  // directory of WordNet 3.x database files, used by wordnet provider
+}
+
+// Default config
+func Default() *Config {
+	homeDir, _ := os.UserHomeDir()
+
+	return &Config{
+		Output:    "short",
+		Lang:      "en",
+		DBPath:    filepath.Join(homeDir, ".def"),
+		Player:    "auto",
+		Sink:      "aplay -q -f S16_LE -c 2 -r {rate}",
+		TTS:       "espeak-ng --stdout {word}",
+		Examples:  3,
+		Providers: []string{"vocabulary", "wiktionary", "wordnet", "stardict"},
+
+		DictServer:   "dict.org:2628",
+		DictDatabase: "*",
+
+		StarDict: []string{filepath.Join(homeDir, ".stardict", "dic")},
+		WordNet:  "/usr/share/wordnet",
+	}
+}
+
+// Path of config file,
+// $DEF_CONFIG or $XDG_CONFIG_HOME/def/config.toml
+func Path() string {
+	if path := os.Getenv("DEF_CONFIG"); path != "" {
+		return path
+	}
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(configDir, "def", "config.toml")
+}
+
+// Load config from path over defaults,
+// a missing config file isn't an error,
+// environment variables override config file,
+// if config file is invalid, they override defaults
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	var err error
+	if path != "" {
+		_, err = toml.DecodeFile(path, cfg)
+		if os.IsNotExist(err) {
+			err = nil
+		} else if err != nil {
+			cfg = Default()
+		}
+	}
+
+	cfg.env()
+
+	return cfg, err
+}
+
+// env overrides config with environment variables, if set
+//
+//	DEF_OUTPUT, DEF_LANG, DEF_DB_PATH, DEF_PLAYER, DEF_SINK, DEF_TTS, DEF_EXAMPLES,
+//	DEF_COLOR, DEF_PROVIDERS (comma separated), DEF_OFFLINE, DEF_MAX_AGE,
+//	DEF_DICT_SERVER, DEF_DICT_DATABASE, DEF_STARDICT (comma separated),
+//	DEF_WORDNET
+func (cfg *Config) env() {
+	if val, ok := os.LookupEnv("DEF_OUTPUT"); ok {
+		cfg.Output = val
+	}
+	if val, ok := os.LookupEnv("DEF_LANG"); ok {
+		cfg.Lang = val
+	}
+	if val, ok := os.LookupEnv("DEF_DB_PATH"); ok {
+		cfg.DBPath = val
+	}
+	if val, ok := os.LookupEnv("DEF_PLAYER"); ok {
+		cfg.Player = val
+	}
+	if val, ok := os.LookupEnv("DEF_SINK"); ok {
+		cfg.Sink = val
+	}
+	if val, ok := os.LookupEnv("DEF_TTS"); ok {
+		cfg.TTS = val
+	}
+	if val, ok := os.LookupEnv("DEF_EXAMPLES"); ok {
+		if n, err := strconv.Atoi(val); err == nil {
+			cfg.Examples = n
+		}
+	}
+	if val, ok := os.LookupEnv("DEF_COLOR"); ok {
+		cfg.Color, _ = strconv.ParseBool(val)
+	}
+	if val, ok := os.LookupEnv("DEF_PROVIDERS"); ok {
+		cfg.Providers = strings.Split(val, ",")
+	}
+	if val, ok := os.LookupEnv("DEF_OFFLINE"); ok {
+		cfg.Offline, _ = strconv.ParseBool(val)
+	}
+	if val, ok := os.LookupEnv("DEF_MAX_AGE"); ok {
+		cfg.MaxAge = val
+	}
+	if val, ok := os.LookupEnv("DEF_DICT_SERVER"); ok {
+		cfg.DictServer = val
+	}
+	if val, ok := os.LookupEnv("DEF_DICT_DATABASE"); ok {
+		cfg.DictDatabase = val
+	}
+	if val, ok := os.LookupEnv("DEF_STARDICT"); ok {
+		cfg.StarDict = strings.Split(val, ",")
+	}
+	if val, ok := os.LookupEnv("DEF_WORDNET"); ok {
+		cfg.WordNet = val
+	}
+}
+
+// ParseAge parses durations like time.ParseDuration,
+// additionally supporting days, ex: "180d", "12h"
+func ParseAge(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		n, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid age %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	return time.ParseDuration(s)
+}
+
+// String returns config in toml format
+func (cfg *Config) String() string {
+	b := &strings.Builder{}
+	toml.NewEncoder(b).Encode(cfg)
+	return b.String()
+}
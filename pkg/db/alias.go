@@ -0,0 +1,143 @@
+package db
+
+import (
+	"github.com/dgraph-io/badger/v2"
+	"github.com/nilsocket/def/pkg/lang"
+)
+
+// aliasPrefix ,other forms of words, ex: "ran" => "run",
+// stored as aliasPrefix + key of alias in Lang => key of word
+const aliasPrefix = internalPrefix + "alias/"
+
+func aliasKey(alias string) []byte {
+	return []byte(aliasPrefix + lang.Key(Lang, lang.Clean(alias)))
+}
+
+// PutAlias stores alias of word
+func PutAlias(alias, word string) error {
+	return db.Update(func(txn *badger.Txn) error {
+		return txn.Set(aliasKey(alias), []byte(lang.Clean(word)))
+	})
+}
+
+// Alias returns word, alias refers to,
+// ErrKeyNotFound if it isn't an alias
+func Alias(alias string) (string, error) {
+	var word string
+
+	err := db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(aliasKey(alias))
+		if err != nil {
+			return err
+		}
+
+		val, err := item.ValueCopy(nil)
+		word = string(val)
+		return err
+	})
+
+	if err == badger.ErrKeyNotFound {
+		return "", ErrKeyNotFound
+	}
+
+	return word, err
+}
+
+// DelAlias removes alias
+func DelAlias(alias string) error {
+	if _, err := Alias(alias); err != nil {
+		return err
+	}
+
+	return db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(aliasKey(alias))
+	})
+}
+
+// Aliases of Lang, calls fn for each alias and word it refers to
+func Aliases(fn func(alias, word string)) {
+	prefix := aliasPrefix + lang.Key(Lang, "")
+
+	db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{Prefix: []byte(prefix)})
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			keyLang, alias := lang.Split(string(it.Item().Key()[len(aliasPrefix):]))
+			if keyLang != Lang {
+				continue
+			}
+
+			val, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			fn(alias, string(val))
+		}
+
+		return nil
+	})
+}
+
+// delAliasesOf word, aliases referring to word are removed
+func delAliasesOf(txn *badger.Txn, word string) error {
+	it := txn.NewIterator(badger.IteratorOptions{Prefix: []byte(aliasPrefix)})
+	defer it.Close()
+
+	var keys [][]byte
+	for it.Rewind(); it.Valid(); it.Next() {
+		keyLang, _ := lang.Split(string(it.Item().Key()[len(aliasPrefix):]))
+		if keyLang != Lang {
+			continue
+		}
+
+		val, err := it.Item().ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+
+		if string(val) == word {
+			keys = append(keys, it.Item().KeyCopy(nil))
+		}
+	}
+
+	for _, key := range keys {
+		if err := txn.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// repointAliases referring to word from, to word to
+func repointAliases(txn *badger.Txn, from, to string) error {
+	it := txn.NewIterator(badger.IteratorOptions{Prefix: []byte(aliasPrefix)})
+	defer it.Close()
+
+	var keys [][]byte
+	for it.Rewind(); it.Valid(); it.Next() {
+		keyLang, _ := lang.Split(string(it.Item().Key()[len(aliasPrefix):]))
+		if keyLang != Lang {
+			continue
+		}
+
+		val, err := it.Item().ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+
+		if string(val) == from {
+			keys = append(keys, it.Item().KeyCopy(nil))
+		}
+	}
+
+	for _, key := range keys {
+		if err := txn.Set(key, []byte(to)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,328 @@
+package db
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/hex"
+	"strings"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/nilsocket/def/pkg/vocab"
+)
+
+// internalPrefix ,keys starting with it
+// aren't words, they belong to other keyspaces
+const internalPrefix = "\x00"
+
+// audioPrefix ,audios are stored by hash of their content,
+// so that words sharing an audio, store it only once
+const audioPrefix = internalPrefix + "audio/"
+
+// audioRefPrefix ,number of words and previous versions referring
+// to an audio, stored as audioRefPrefix + id, big endian uint64,
+// audio is removed when it's no longer referred
+const audioRefPrefix = internalPrefix + "audioref/"
+
+// audioRefsCountedKey ,exists once references of audios
+// stored before reference counts existed are counted
+const audioRefsCountedKey = internalPrefix + "meta/audio-refs-counted/1"
+
+func isInternal(key []byte) bool {
+	return bytes.HasPrefix(key, []byte(internalPrefix))
+}
+
+// AudioID returns key of audio
+func AudioID(a vocab.Audio) string {
+	sum := sha256.Sum256(a)
+	return hex.EncodeToString(sum[:])
+}
+
+// putAudios stores audios, which aren't already stored,
+// and returns their ids, references are counted by refAudios
+func putAudios(txn *badger.Txn, audios []vocab.Audio) ([]string, error) {
+	var ids []string
+
+	for _, a := range audios {
+		id := AudioID(a)
+		ids = append(ids, id)
+
+		_, err := txn.Get([]byte(audioPrefix + id))
+		if err == nil {
+			continue // already stored
+		} else if err != badger.ErrKeyNotFound {
+			return nil, err
+		}
+
+		if err := txn.Set([]byte(audioPrefix+id), a); err != nil {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+// refAudios adds delta to reference count of each audio,
+// audios no longer referred are removed
+func refAudios(txn *badger.Txn, ids []string, delta int64) error {
+	for _, id := range ids {
+		refs := int64(0)
+
+		item, err := txn.Get([]byte(audioRefPrefix + id))
+		if err == nil {
+			err = item.Value(func(val []byte) error {
+				if len(val) == 8 {
+					refs = int64(binary.BigEndian.Uint64(val))
+				}
+				return nil
+			})
+		}
+		if err != nil && err != badger.ErrKeyNotFound {
+			return err
+		}
+
+		refs += delta
+
+		if refs > 0 {
+			val := make([]byte, 8)
+			binary.BigEndian.PutUint64(val, uint64(refs))
+			if err := txn.Set([]byte(audioRefPrefix+id), val); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := txn.Delete([]byte(audioRefPrefix + id)); err != nil {
+			return err
+		}
+		if err := txn.Delete([]byte(audioPrefix + id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// audioIDs of stored word, or a version of it
+func audioIDs(item *badger.Item) ([]string, error) {
+	w := &vocab.Word{}
+
+	err := item.Value(func(val []byte) error {
+		return gob.NewDecoder(bytes.NewReader(val)).Decode(w)
+	})
+
+	return w.AudioIDs, err
+}
+
+// storedAudioIDs of key, nil if it isn't stored
+func storedAudioIDs(txn *badger.Txn, key []byte) ([]string, error) {
+	item, err := txn.Get(key)
+	if err == badger.ErrKeyNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return audioIDs(item)
+}
+
+// delAudiosOf word, references of word and it's versions
+// to audios are dropped, should be called before deleting word
+func delAudiosOf(txn *badger.Txn, key string) error {
+	ids, err := storedAudioIDs(txn, wordKey(key))
+	if err != nil {
+		return err
+	}
+
+	it := txn.NewIterator(badger.IteratorOptions{Prefix: []byte(historyKey(key))})
+	for it.Rewind(); it.Valid(); it.Next() {
+		vIDs, err := audioIDs(it.Item())
+		if err != nil {
+			it.Close()
+			return err
+		}
+		ids = append(ids, vIDs...)
+	}
+	it.Close()
+
+	return refAudios(txn, ids, -1)
+}
+
+// countAudioRefs counts references of audios by words of all languages,
+// and previous versions of them, stored counts are replaced
+func countAudioRefs() (map[string]int64, error) {
+	refs := map[string]int64{}
+
+	err := db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{})
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			key := it.Item().Key()
+			if isInternal(key) && !bytes.HasPrefix(key, []byte(historyPrefix)) {
+				continue
+			}
+
+			ids, err := audioIDs(it.Item())
+			if err != nil {
+				return err
+			}
+
+			for _, id := range ids {
+				refs[id]++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.DropPrefix([]byte(audioRefPrefix)); err != nil {
+		return nil, err
+	}
+
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+
+	for id, n := range refs {
+		val := make([]byte, 8)
+		binary.BigEndian.PutUint64(val, uint64(n))
+		if err := wb.Set([]byte(audioRefPrefix+id), val); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := wb.Set([]byte(audioRefsCountedKey), nil); err != nil {
+		return nil, err
+	}
+
+	return refs, wb.Flush()
+}
+
+// LoadAudios of word, if they aren't loaded
+func LoadAudios(w *vocab.Word) error {
+	if len(w.Audios) != 0 || len(w.AudioIDs) == 0 {
+		return nil
+	}
+
+	return db.View(func(txn *badger.Txn) error {
+		for _, id := range w.AudioIDs {
+			item, err := txn.Get([]byte(audioPrefix + id))
+			if err != nil {
+				return err
+			}
+
+			a, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			w.Audios = append(w.Audios, a)
+		}
+
+		return nil
+	})
+}
+
+// AudioStats ,result of CompactAudios
+type AudioStats struct {
+	Words    int   // words with audio
+	Moved    int   // words whose embedded audios are moved to audio keyspace
+	Audios   int   // audios referred by words
+	Unique   int   // audios stored
+	Removed  int   // unreferenced audios removed
+	Embedded int64 // bytes, if every word stored it's own audios
+	Stored   int64 // bytes, actually stored
+}
+
+// Saved bytes by storing audios separately
+func (s *AudioStats) Saved() int64 {
+	return s.Embedded - s.Stored
+}
+
+// CompactAudios moves audios embedded in words (stored by older versions)
+// to audio keyspace, recounts references of audios, removes audios
+// which aren't referred by any word or previous version of a word,
+// and returns stats of audio storage
+func CompactAudios() (*AudioStats, error) {
+	stats := &AudioStats{}
+
+	var keys []string
+	Iterate(func(key string) {
+		keys = append(keys, key)
+	})
+
+	for _, key := range keys {
+		w, err := Get(key)
+		if err != nil {
+			return nil, err
+		}
+
+		// embedded audios
+		if len(w.Audios) != 0 {
+			if err := Put(key, w); err != nil {
+				return nil, err
+			}
+			stats.Moved++
+		}
+
+		if len(w.AudioIDs) != 0 {
+			stats.Words++
+		}
+
+		stats.Audios += len(w.AudioIDs)
+
+		if err := LoadAudios(w); err != nil {
+			return nil, err
+		}
+		for _, a := range w.Audios {
+			stats.Embedded += int64(len(a))
+		}
+	}
+
+	refs, err := countAudioRefs()
+	if err != nil {
+		return nil, err
+	}
+
+	var unreferred [][]byte
+
+	err = db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{Prefix: []byte(audioPrefix)})
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			id := strings.TrimPrefix(string(item.Key()), audioPrefix)
+
+			if refs[id] == 0 {
+				unreferred = append(unreferred, item.KeyCopy(nil))
+				continue
+			}
+
+			stats.Unique++
+			stats.Stored += item.ValueSize()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// a batch, as unreferred audios may not fit in a transaction
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+
+	for _, key := range unreferred {
+		if err := wb.Delete(key); err != nil {
+			return nil, err
+		}
+		stats.Removed++
+	}
+
+	return stats, wb.Flush()
+}
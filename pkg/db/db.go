@@ -7,11 +7,25 @@ import (
 	"strings"
 
 	"github.com/dgraph-io/badger/v2"
+	"github.com/nilsocket/def/pkg/lang"
 	"github.com/nilsocket/def/pkg/vocab"
 )
 
 var db *badger.DB
 
+// Lang ,language of words got, put and iterated,
+// words of different languages are stored separately
+var Lang = lang.Default
+
+// wordKey ,key of word in Lang, see lang.Clean,
+// internal keys are left as is
+func wordKey(key string) []byte {
+	if isInternal([]byte(key)) {
+		return []byte(key)
+	}
+	return []byte(lang.Key(Lang, lang.Clean(key)))
+}
+
 // ErrKeyNotFound is returned when key isn't found
 var ErrKeyNotFound = badger.ErrKeyNotFound
 
@@ -24,6 +38,18 @@ func Open(path string) {
 	if err != nil {
 		log.Fatalln(err)
 	}
+
+	if !isIndexed() {
+		if err := reindex(); err != nil {
+			log.Println("reindex", err)
+		}
+	}
+
+	if !Has(audioRefsCountedKey) {
+		if _, err := countAudioRefs(); err != nil {
+			log.Println("count audio references", err)
+		}
+	}
 }
 
 // Get key
@@ -32,7 +58,7 @@ func Get(key string) (*vocab.Word, error) {
 
 	err := db.View(func(txn *badger.Txn) error {
 
-		item, err := txn.Get([]byte(key))
+		item, err := txn.Get(wordKey(key))
 		if err != nil {
 			return err
 		}
@@ -60,21 +86,59 @@ func Get(key string) (*vocab.Word, error) {
 	return word, nil
 }
 
-// Put key and val into db
+// Has reports whether key exists in db
+func Has(key string) bool {
+	err := db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(wordKey(key))
+		return err
+	})
+
+	return err == nil
+}
+
+// Put key and val into db,
+// audios are stored separately, see putAudios
 func Put(key string, val *vocab.Word) error {
 	b := &strings.Builder{}
 
-	// encode
-	err := gob.NewEncoder(b).Encode(val)
-	if err != nil {
-		log.Println(err)
-		return err
-	}
-
 	// Update
-	err = db.Update(func(txn *badger.Txn) error {
-		err := txn.Set([]byte(key), []byte(b.String()))
-		return err
+	err := db.Update(func(txn *badger.Txn) error {
+		oldIDs, err := storedAudioIDs(txn, wordKey(key))
+		if err != nil {
+			return err
+		}
+
+		ids, err := putAudios(txn, val.Audios)
+		if err != nil {
+			return err
+		}
+
+		if len(ids) != 0 {
+			val.AudioIDs = ids
+		}
+
+		// refer new audios before dropping old ones, they may be same
+		if err := refAudios(txn, val.AudioIDs, 1); err != nil {
+			return err
+		}
+		if err := refAudios(txn, oldIDs, -1); err != nil {
+			return err
+		}
+
+		// audios aren't part of word
+		stored := *val
+		stored.Audios = nil
+
+		// encode
+		if err := gob.NewEncoder(b).Encode(&stored); err != nil {
+			return err
+		}
+
+		if err := txn.Set(normKey(Lang, lang.Clean(key)), nil); err != nil {
+			return err
+		}
+
+		return txn.Set(wordKey(key), []byte(b.String()))
 	})
 
 	if err != nil {
@@ -84,15 +148,50 @@ func Put(key string, val *vocab.Word) error {
 	return err
 }
 
-// Del key from db
+// Del key, along with it's previous versions,
+// aliases referring to it, and audios no other word refers from db
 func Del(key string) error {
 	return db.Update(func(txn *badger.Txn) error {
-		txn.Delete([]byte(key))
-		return nil
+		if err := delAudiosOf(txn, key); err != nil {
+			return err
+		}
+		txn.Delete(wordKey(key))
+		txn.Delete(normKey(Lang, lang.Clean(key)))
+		if err := delAliasesOf(txn, lang.Clean(key)); err != nil {
+			return err
+		}
+		return delVersions(txn, key)
 	})
 }
 
-// Iterate over all key value pairs and execute fn for each key
+// Rename stored word from, to word to, which should be stored already,
+// previous versions and aliases of from are moved to to,
+// and from becomes an alias of to
+func Rename(from, to string) error {
+	from, to = lang.Clean(from), lang.Clean(to)
+
+	return db.Update(func(txn *badger.Txn) error {
+		ids, err := storedAudioIDs(txn, wordKey(from))
+		if err != nil {
+			return err
+		}
+		if err := refAudios(txn, ids, -1); err != nil {
+			return err
+		}
+
+		txn.Delete(wordKey(from))
+		txn.Delete(normKey(Lang, from))
+		if err := repointAliases(txn, from, to); err != nil {
+			return err
+		}
+		if err := moveVersions(txn, from, to); err != nil {
+			return err
+		}
+		return txn.Set(aliasKey(from), []byte(to))
+	})
+}
+
+// Iterate over all words of Lang and execute fn for each key
 func Iterate(fn func(key string)) {
 	db.View(func(txn *badger.Txn) error {
 		opts := badger.IteratorOptions{}
@@ -101,7 +200,15 @@ func Iterate(fn func(key string)) {
 
 		for it.Rewind(); it.Valid(); it.Next() {
 			key := it.Item().Key()
-			fn(string(key))
+
+			// skip keys of other keyspaces
+			if isInternal(key) {
+				continue
+			}
+
+			if keyLang, word := lang.Split(string(key)); keyLang == Lang {
+				fn(word)
+			}
 		}
 
 		it.Close()
@@ -112,5 +219,13 @@ func Iterate(fn func(key string)) {
 
 // Close db
 func Close() {
-	db.Close()
+	if db != nil {
+		db.Close()
+		db = nil
+	}
+}
+
+// IsOpen reports whether db is open
+func IsOpen() bool {
+	return db != nil
 }
@@ -0,0 +1,127 @@
+package db
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/nilsocket/def/pkg/vocab"
+)
+
+// historyPrefix ,previous versions of a word are stored as
+// historyPrefix + word + "\x00" + time of archiving
+const historyPrefix = internalPrefix + "history/"
+
+// historyKey ,words don't contain "\x00",
+// so that versions of "a" and "a b" don't mix
+func historyKey(key string) string {
+	return historyPrefix + string(wordKey(key)) + "\x00"
+}
+
+// PutVersion archives w as a previous version of key,
+// audios are referred by their ids
+func PutVersion(key string, w *vocab.Word) error {
+	versionKey := historyKey(key) + fmt.Sprintf("%020d", time.Now().UnixNano())
+
+	return db.Update(func(txn *badger.Txn) error {
+		// audios embedded by older versions of def
+		ids, err := putAudios(txn, w.Audios)
+		if err != nil {
+			return err
+		}
+
+		stored := *w
+		stored.Audios = nil
+		if len(ids) != 0 {
+			stored.AudioIDs = ids
+		}
+
+		if err := refAudios(txn, stored.AudioIDs, 1); err != nil {
+			return err
+		}
+
+		b := &bytes.Buffer{}
+		if err := gob.NewEncoder(b).Encode(&stored); err != nil {
+			return err
+		}
+
+		return txn.Set([]byte(versionKey), b.Bytes())
+	})
+}
+
+// Versions of key, oldest first
+func Versions(key string) ([]*vocab.Word, error) {
+	var versions []*vocab.Word
+
+	err := db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{Prefix: []byte(historyKey(key))})
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			w := &vocab.Word{}
+
+			err := it.Item().Value(func(val []byte) error {
+				return gob.NewDecoder(bytes.NewReader(val)).Decode(w)
+			})
+			if err != nil {
+				return err
+			}
+
+			versions = append(versions, w)
+		}
+
+		return nil
+	})
+
+	return versions, err
+}
+
+// delVersions of key
+func delVersions(txn *badger.Txn, key string) error {
+	it := txn.NewIterator(badger.IteratorOptions{Prefix: []byte(historyKey(key))})
+
+	var keys [][]byte
+	for it.Rewind(); it.Valid(); it.Next() {
+		keys = append(keys, it.Item().KeyCopy(nil))
+	}
+	it.Close()
+
+	for _, k := range keys {
+		if err := txn.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// moveVersions of key from to key to
+func moveVersions(txn *badger.Txn, from, to string) error {
+	prefix := historyKey(from)
+	it := txn.NewIterator(badger.IteratorOptions{PrefetchValues: true, Prefix: []byte(prefix)})
+
+	var keys, vals [][]byte
+	for it.Rewind(); it.Valid(); it.Next() {
+		val, err := it.Item().ValueCopy(nil)
+		if err != nil {
+			it.Close()
+			return err
+		}
+		keys = append(keys, it.Item().KeyCopy(nil))
+		vals = append(vals, val)
+	}
+	it.Close()
+
+	for i, k := range keys {
+		if err := txn.Set([]byte(historyKey(to)+string(k[len(prefix):])), vals[i]); err != nil {
+			return err
+		}
+		if err := txn.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package db
+
+import (
+	"bytes"
+	"encoding/gob"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/nilsocket/def/pkg/vocab"
+)
+
+// importPrefix ,words of imported dictionaries are stored as
+// importPrefix + source + "@" + generation + "\x00" + word,
+// ex: source "wiktionary/en"
+const importPrefix = internalPrefix + "import/"
+
+// importGenPrefix ,current generation of source is stored as
+// importGenPrefix + source, so that an import replaces
+// previous one only when it's complete
+const importGenPrefix = internalPrefix + "import-gen/"
+
+func importKey(source, word string) string {
+	return importPrefix + source + "\x00" + word
+}
+
+// currentSource ,source along with it's current generation,
+// source itself, if it's imported by older versions of def
+func currentSource(source string) (string, error) {
+	var gen []byte
+
+	err := db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(importGenPrefix + source))
+		if err != nil {
+			return err
+		}
+
+		gen, err = item.ValueCopy(nil)
+		return err
+	})
+
+	if err == badger.ErrKeyNotFound {
+		return source, nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return source + "@" + string(gen), nil
+}
+
+// Importer ,writes words of source in batches,
+// words are visible only after Flush
+type Importer struct {
+	source string
+	gen    string
+	wb     *badger.WriteBatch
+	seen   map[string]bool
+	Count  int
+}
+
+// NewImporter of source, previously imported
+// words of source are replaced after Flush
+func NewImporter(source string) (*Importer, error) {
+	return &Importer{
+		source: source,
+		gen:    strconv.FormatInt(time.Now().UnixNano(), 10),
+		wb:     db.NewWriteBatch(),
+		seen:   map[string]bool{},
+	}, nil
+}
+
+// key of word in generation being imported
+func (im *Importer) key(word string) []byte {
+	return []byte(importKey(im.source+"@"+im.gen, word))
+}
+
+// Get word put earlier in this import,
+// ErrKeyNotFound if it isn't
+func (im *Importer) Get(word string) (*vocab.Word, error) {
+	if !im.seen[word] {
+		return nil, ErrKeyNotFound
+	}
+
+	// pending words aren't readable
+	if err := im.wb.Flush(); err != nil {
+		return nil, err
+	}
+	im.wb = db.NewWriteBatch()
+
+	return getImported(im.key(word))
+}
+
+// Put word, audios aren't imported
+func (im *Importer) Put(w *vocab.Word) error {
+	stored := *w
+	stored.Audios = nil
+
+	b := &bytes.Buffer{}
+	if err := gob.NewEncoder(b).Encode(&stored); err != nil {
+		return err
+	}
+
+	if err := im.wb.Set(im.key(w.Word), b.Bytes()); err != nil {
+		return err
+	}
+
+	if !im.seen[w.Word] {
+		im.seen[w.Word] = true
+		im.Count++
+	}
+	return nil
+}
+
+// Flush pending words, and replace previously
+// imported words of source with them
+func (im *Importer) Flush() error {
+	if err := im.wb.Flush(); err != nil {
+		return err
+	}
+
+	prev, err := currentSource(im.source)
+	if err != nil {
+		return err
+	}
+
+	err = db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(importGenPrefix+im.source), []byte(im.gen))
+	})
+	if err != nil {
+		return err
+	}
+
+	return db.DropPrefix([]byte(importKey(prev, "")))
+}
+
+// Cancel import, words put are discarded,
+// previously imported words of source are kept
+func (im *Importer) Cancel() {
+	im.wb.Cancel()
+
+	if err := db.DropPrefix([]byte(importKey(im.source+"@"+im.gen, ""))); err != nil {
+		log.Println("import", err)
+	}
+}
+
+// GetImported word of source
+func GetImported(source, word string) (*vocab.Word, error) {
+	source, err := currentSource(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return getImported([]byte(importKey(source, word)))
+}
+
+func getImported(key []byte) (*vocab.Word, error) {
+	w := &vocab.Word{}
+
+	err := db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+
+		return item.Value(func(val []byte) error {
+			return gob.NewDecoder(bytes.NewReader(val)).Decode(w)
+		})
+	})
+
+	if err == badger.ErrKeyNotFound {
+		return nil, ErrKeyNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// HasImported reports whether word of source is imported
+func HasImported(source, word string) bool {
+	source, err := currentSource(source)
+	if err != nil {
+		return false
+	}
+
+	return Has(importKey(source, word))
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"github.com/dgraph-io/badger/v2"
+	"github.com/nilsocket/def/pkg/lang"
+)
+
+// normPrefix ,index of words by their folded form, stored as
+// normPrefix + folded form in lang + "\x00" + word, see lang.Fold
+const normPrefix = internalPrefix + "norm/"
+
+// normIndexedKey ,exists once words stored
+// before index existed are indexed,
+// version changes whenever folding does
+const normIndexedKey = internalPrefix + "meta/norm-indexed/2"
+
+func normKey(l, word string) []byte {
+	return []byte(normPrefix + lang.Key(l, lang.Fold(l, word)) + "\x00" + word)
+}
+
+// Variants of word in Lang, stored words which are written
+// differently, ex: "cafe" => "café", "Café", including word if stored
+func Variants(word string) []string {
+	var variants []string
+	prefix := normPrefix + lang.Key(Lang, lang.Fold(Lang, word)) + "\x00"
+
+	db.View(func(txn *badger.Txn) error {
+		opts := badger.IteratorOptions{Prefix: []byte(prefix)}
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			variants = append(variants, string(it.Item().Key()[len(prefix):]))
+		}
+
+		return nil
+	})
+
+	return variants
+}
+
+// isIndexed reports whether words are indexed,
+// by current version of index
+func isIndexed() bool {
+	return Has(normIndexedKey)
+}
+
+// reindex words, index of previous version is removed
+func reindex() error {
+	if err := db.DropPrefix([]byte(normPrefix)); err != nil {
+		return err
+	}
+
+	wb := db.NewWriteBatch()
+	defer wb.Cancel()
+
+	err := db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{})
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			key := it.Item().Key()
+			if isInternal(key) {
+				continue
+			}
+
+			l, word := lang.Split(string(key))
+			if err := wb.Set(normKey(l, word), nil); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := wb.Set([]byte(normIndexedKey), nil); err != nil {
+		return err
+	}
+
+	return wb.Flush()
+}
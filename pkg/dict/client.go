@@ -0,0 +1,165 @@
+package dict
+
+import (
+	"fmt"
+	"net"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// Definition ,a definition block returned by DEFINE
+type Definition struct {
+	Word        string
+	Database    string
+	Description string // of database
+	Text        string
+}
+
+// Client of a dict server
+type Client struct {
+	text *textproto.Conn
+}
+
+// Dial connects to dict server at addr,
+// port defaults to DefaultPort
+func Dial(addr string) (*Client, error) {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, DefaultPort)
+	}
+
+	conn, err := net.DialTimeout("tcp", addr, 10*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{text: textproto.NewConn(conn)}
+
+	if _, _, err := c.text.ReadCodeLine(220); err != nil {
+		c.text.Close()
+		return nil, err
+	}
+
+	if _, err := c.cmd(250, "CLIENT %s", quote("def")); err != nil {
+		c.text.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Close sends QUIT, and closes connection
+func (c *Client) Close() error {
+	c.cmd(221, "QUIT")
+	return c.text.Close()
+}
+
+// cmd sends command and reads status line,
+// expecting code, see textproto.Reader.ReadCodeLine
+func (c *Client) cmd(code int, format string, args ...interface{}) (string, error) {
+	id, err := c.text.Cmd(format, args...)
+	if err != nil {
+		return "", err
+	}
+
+	c.text.StartResponse(id)
+	defer c.text.EndResponse(id)
+
+	_, msg, err := c.text.ReadCodeLine(code)
+	return msg, err
+}
+
+// Define word in database, "*" for all databases,
+// nil if there is no match
+func (c *Client) Define(database, word string) ([]Definition, error) {
+	id, err := c.text.Cmd("DEFINE %s %s", quote(database), quote(word))
+	if err != nil {
+		return nil, err
+	}
+
+	c.text.StartResponse(id)
+	defer c.text.EndResponse(id)
+
+	code, msg, err := c.text.ReadCodeLine(150)
+	if code == 552 { // no match
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var defs []Definition
+
+	for {
+		code, msg, err = c.text.ReadCodeLine(0)
+		if err != nil {
+			return nil, err
+		}
+
+		if code == 250 {
+			return defs, nil
+		} else if code != 151 {
+			return nil, &textproto.Error{Code: code, Msg: msg}
+		}
+
+		// 151 "word" database "description"
+		args := splitArgs(msg)
+		if len(args) < 2 {
+			return nil, fmt.Errorf("dict: invalid definition header %q", msg)
+		}
+
+		def := Definition{Word: args[0], Database: args[1]}
+		if len(args) > 2 {
+			def.Description = args[2]
+		}
+
+		lines, err := c.text.ReadDotLines()
+		if err != nil {
+			return nil, err
+		}
+		def.Text = strings.Join(lines, "\n")
+
+		defs = append(defs, def)
+	}
+}
+
+// Match words in database using strategy, "." for server default,
+// returns matched words, nil if there is no match
+func (c *Client) Match(database, strategy, word string) ([]string, error) {
+	id, err := c.text.Cmd("MATCH %s %s %s", quote(database), strategy, quote(word))
+	if err != nil {
+		return nil, err
+	}
+
+	c.text.StartResponse(id)
+	defer c.text.EndResponse(id)
+
+	code, _, err := c.text.ReadCodeLine(152)
+	if code == 552 { // no match
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	lines, err := c.text.ReadDotLines()
+	if err != nil {
+		return nil, err
+	}
+
+	var words []string
+	seen := map[string]bool{}
+
+	// database "word"
+	for _, line := range lines {
+		args := splitArgs(line)
+		if len(args) == 2 && !seen[args[1]] {
+			seen[args[1]] = true
+			words = append(words, args[1])
+		}
+	}
+
+	if _, _, err := c.text.ReadCodeLine(250); err != nil {
+		return nil, err
+	}
+
+	return words, nil
+}
@@ -0,0 +1,116 @@
+package dict
+
+import (
+	"net"
+	"net/textproto"
+	"reflect"
+	"testing"
+)
+
+// fakeResponses ,responses of fake server by command
+var fakeResponses = map[string]string{
+	`CLIENT "def"`: "250 ok\r\n",
+	`DEFINE "*" "dog"`: "150 1 definitions retrieved\r\n" +
+		"151 \"dog\" wn \"WordNet (r) 3.0\"\r\n" +
+		"dog\r\n" +
+		"    n 1: a domesticated carnivore\r\n" +
+		"..dotted line\r\n" +
+		".\r\n" +
+		"250 ok\r\n",
+	`DEFINE "*" "dgo"`:       "552 no match\r\n",
+	`DEFINE "*" "ice cream"`: "550 invalid database\r\n",
+	`MATCH "*" lev "dgo"`: "152 3 matches found\r\n" +
+		"wn \"dog\"\r\n" +
+		"wn \"dig\"\r\n" +
+		"gcide \"dog\"\r\n" +
+		".\r\n" +
+		"250 ok\r\n",
+	`MATCH "*" lev "zzzz"`: "552 no match\r\n",
+	"QUIT":                 "221 bye\r\n",
+}
+
+// fakeServer serves one connection on a local port,
+// replying to commands with fakeResponses
+func fakeServer(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		text := textproto.NewConn(conn)
+		text.PrintfLine("220 fake <mime> <1@fake>")
+
+		for {
+			line, err := text.ReadLine()
+			if err != nil {
+				return
+			}
+
+			resp, ok := fakeResponses[line]
+			if !ok {
+				t.Errorf("unexpected command %q", line)
+				resp = "500 unknown command\r\n"
+			}
+
+			conn.Write([]byte(resp))
+			if line == "QUIT" {
+				return
+			}
+		}
+	}()
+
+	return l.Addr().String()
+}
+
+func TestClient(t *testing.T) {
+	c, err := Dial(fakeServer(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	defs, err := c.Define("*", "dog")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Definition{{
+		Word:        "dog",
+		Database:    "wn",
+		Description: "WordNet (r) 3.0",
+		Text:        "dog\n    n 1: a domesticated carnivore\n.dotted line",
+	}}
+	if !reflect.DeepEqual(defs, want) {
+		t.Errorf("Define(dog) = %#v, want %#v", defs, want)
+	}
+
+	defs, err = c.Define("*", "dgo")
+	if err != nil || defs != nil {
+		t.Errorf("Define(dgo) = %v, %v, want no match", defs, err)
+	}
+
+	if _, err := c.Define("*", "ice cream"); err == nil {
+		t.Error("Define(ice cream) error = nil, want 550")
+	}
+
+	words, err := c.Match("*", "lev", "dgo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"dog", "dig"}; !reflect.DeepEqual(words, want) {
+		t.Errorf("Match(dgo) = %v, want %v", words, want)
+	}
+
+	words, err = c.Match("*", "lev", "zzzz")
+	if err != nil || words != nil {
+		t.Errorf("Match(zzzz) = %v, %v, want no match", words, err)
+	}
+}
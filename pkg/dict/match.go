@@ -0,0 +1,133 @@
+package dict
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Strategy ,matching strategy of MATCH command
+type Strategy struct {
+	Name        string
+	Description string
+	Match       func(query, word string) bool
+}
+
+// Strategies supported by server,
+// first one is the default strategy
+var Strategies = []Strategy{
+	{"exact", "Match headwords exactly, ignoring case", exact},
+	{"prefix", "Match prefixes", prefix},
+	{"soundex", "Match using SOUNDEX algorithm", func(q, w string) bool { return Soundex(q) == Soundex(w) }},
+	{"lev", "Match headwords within Levenshtein distance one", func(q, w string) bool { return Levenshtein(strings.ToLower(q), strings.ToLower(w)) <= 1 }},
+}
+
+func strategy(name string) *Strategy {
+	if name == "." {
+		return &Strategies[0]
+	}
+
+	for i := range Strategies {
+		if Strategies[i].Name == name {
+			return &Strategies[i]
+		}
+	}
+
+	return nil
+}
+
+func exact(q, w string) bool {
+	return strings.EqualFold(q, w)
+}
+
+func prefix(q, w string) bool {
+	return strings.HasPrefix(strings.ToLower(w), strings.ToLower(q))
+}
+
+// Soundex code of word, ex: "Robert" => "R163"
+func Soundex(word string) string {
+	codes := map[rune]byte{
+		'b': '1', 'f': '1', 'p': '1', 'v': '1',
+		'c': '2', 'g': '2', 'j': '2', 'k': '2', 'q': '2', 's': '2', 'x': '2', 'z': '2',
+		'd': '3', 't': '3',
+		'l': '4',
+		'm': '5', 'n': '5',
+		'r': '6',
+	}
+
+	var code []byte
+	var last byte
+
+	for _, r := range strings.ToLower(word) {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+
+		c := codes[r]
+
+		if len(code) == 0 {
+			code = append(code, byte(unicode.ToUpper(r)))
+			last = c
+			continue
+		}
+
+		switch {
+		case r == 'h' || r == 'w':
+			// don't separate same codes
+		case c == 0:
+			last = 0 // vowels separate same codes
+		case c != last:
+			code = append(code, c)
+			last = c
+		}
+
+		if len(code) == 4 {
+			break
+		}
+	}
+
+	if len(code) == 0 {
+		return ""
+	}
+
+	for len(code) < 4 {
+		code = append(code, '0')
+	}
+
+	return string(code[:4])
+}
+
+// Levenshtein distance between a and b
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,77 @@
+package dict
+
+import (
+	"strings"
+)
+
+// DefaultPort of dict protocol
+const DefaultPort = "2628"
+
+// splitArgs splits a command line into arguments,
+// arguments can be quoted using double or single quotes,
+// and characters can be escaped using \
+func splitArgs(line string) []string {
+	var args []string
+	var b strings.Builder
+
+	inArg := false
+	var quote rune
+	escaped := false
+
+	for _, r := range line {
+		switch {
+		case escaped:
+			b.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped, inArg = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				b.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, b.String())
+				b.Reset()
+				inArg = false
+			}
+		default:
+			b.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if inArg {
+		args = append(args, b.String())
+	}
+
+	return args
+}
+
+// quote arg, ex: `a "b"` => `"a \"b\""`
+func quote(arg string) string {
+	arg = strings.ReplaceAll(arg, `\`, `\\`)
+	arg = strings.ReplaceAll(arg, `"`, `\"`)
+
+	return `"` + arg + `"`
+}
+
+// textLines returns text as lines of a text response,
+// lines starting with "." are escaped by another "."
+func textLines(text string) []string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.TrimRight(text, "\n")
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, ".") {
+			lines[i] = "." + line
+		}
+	}
+
+	return lines
+}
@@ -0,0 +1,259 @@
+package dict
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nilsocket/def/pkg/vocab"
+)
+
+// Server serves words as a single database,
+// over dict protocol, RFC 2229
+type Server struct {
+	Database    string // name of database, ex: "def"
+	Description string
+
+	// Define returns word, nil if it isn't found
+	Define func(word string) *vocab.Word
+
+	// Words executes fn for each headword
+	Words func(fn func(word string))
+}
+
+// ListenAndServe listens on addr, and serves connections
+func (s *Server) ListenAndServe(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	return s.Serve(l)
+}
+
+// Serve connections accepted by l
+func (s *Server) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+
+		go s.serveConn(conn)
+	}
+}
+
+// session ,a client connection
+type session struct {
+	*Server
+	w    *bufio.Writer
+	mime bool // text responses are prefixed by MIME headers, see OPTION MIME
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	ss := &session{Server: s, w: bufio.NewWriter(conn)}
+
+	hostname, _ := os.Hostname()
+	msgID := fmt.Sprintf("<%d.%d@%s>", os.Getpid(), time.Now().UnixNano(), hostname)
+	ss.status(220, hostname+" def dict server <mime> "+msgID)
+	ss.w.Flush()
+
+	r := bufio.NewScanner(conn)
+	for r.Scan() {
+		quit := ss.command(r.Text())
+		if err := ss.w.Flush(); err != nil || quit {
+			return
+		}
+	}
+}
+
+// status writes a status response
+func (ss *session) status(code int, text string) {
+	ss.w.WriteString(strconv.Itoa(code) + " " + text + "\r\n")
+}
+
+// text writes a text response, terminated by "."
+func (ss *session) text(text string) {
+	if ss.mime {
+		ss.w.WriteString("Content-type: text/plain; charset=utf-8\r\n\r\n")
+	}
+
+	for _, line := range textLines(text) {
+		ss.w.WriteString(line + "\r\n")
+	}
+	ss.w.WriteString(".\r\n")
+}
+
+// command executes a command line,
+// returns true if connection has to be closed
+func (ss *session) command(line string) bool {
+	args := splitArgs(line)
+	if len(args) == 0 {
+		ss.status(500, "syntax error, command not recognized")
+		return false
+	}
+
+	switch strings.ToUpper(args[0]) {
+	case "DEFINE":
+		if len(args) != 3 {
+			ss.status(501, "syntax error, illegal parameters")
+			return false
+		}
+		ss.define(args[1], args[2])
+	case "MATCH":
+		if len(args) != 4 {
+			ss.status(501, "syntax error, illegal parameters")
+			return false
+		}
+		ss.match(args[1], args[2], args[3])
+	case "SHOW":
+		ss.show(args[1:])
+	case "CLIENT":
+		ss.status(250, "ok")
+	case "OPTION":
+		if len(args) != 2 || strings.ToUpper(args[1]) != "MIME" {
+			ss.status(501, "syntax error, illegal parameters")
+			return false
+		}
+		ss.mime = true
+		ss.status(250, "ok - using MIME headers")
+	case "STATUS":
+		ss.status(210, "up")
+	case "HELP":
+		ss.status(113, "help text follows")
+		ss.text(help)
+		ss.status(250, "ok")
+	case "QUIT":
+		ss.status(221, "bye")
+		return true
+	case "AUTH", "SASLAUTH":
+		ss.status(502, "command not implemented")
+	default:
+		ss.status(500, "unknown command")
+	}
+
+	return false
+}
+
+var help = `DEFINE database word         -- look up word in database
+MATCH database strategy word -- match word in database using strategy
+SHOW DB                      -- list all accessible databases
+SHOW STRAT                   -- list available matching strategies
+SHOW INFO database           -- provide information about the database
+SHOW SERVER                  -- provide site-specific information
+CLIENT info                  -- identify client to server
+OPTION MIME                  -- use MIME headers
+STATUS                       -- display timing information
+HELP                         -- display this help information
+QUIT                         -- terminate connection`
+
+// validDB reports whether database refers to our database,
+// "*" and "!" refer to all databases
+func (ss *session) validDB(database string) bool {
+	return database == "*" || database == "!" || database == ss.Database
+}
+
+func (ss *session) define(database, word string) {
+	if !ss.validDB(database) {
+		ss.status(550, "invalid database, use \"SHOW DB\" for list of databases")
+		return
+	}
+
+	w := ss.Define(word)
+	if w == nil {
+		ss.status(552, "no match")
+		return
+	}
+
+	ss.status(150, "1 definitions retrieved")
+	ss.status(151, quote(w.Word)+" "+ss.Database+" "+quote(ss.Description))
+	ss.text(Text(w))
+	ss.status(250, "ok")
+}
+
+func (ss *session) match(database, strat, word string) {
+	if !ss.validDB(database) {
+		ss.status(550, "invalid database, use \"SHOW DB\" for list of databases")
+		return
+	}
+
+	st := strategy(strat)
+	if st == nil {
+		ss.status(551, "invalid strategy, use \"SHOW STRAT\" for a list of strategies")
+		return
+	}
+
+	var matches []string
+	ss.Words(func(w string) {
+		if st.Match(word, w) {
+			matches = append(matches, ss.Database+" "+quote(w))
+		}
+	})
+
+	if len(matches) == 0 {
+		ss.status(552, "no match")
+		return
+	}
+
+	ss.status(152, strconv.Itoa(len(matches))+" matches found")
+	ss.text(strings.Join(matches, "\n"))
+	ss.status(250, "ok")
+}
+
+func (ss *session) show(args []string) {
+	if len(args) == 0 {
+		ss.status(501, "syntax error, illegal parameters")
+		return
+	}
+
+	switch strings.ToUpper(args[0]) {
+	case "DB", "DATABASES":
+		ss.status(110, "1 databases present")
+		ss.text(ss.Database + " " + quote(ss.Description))
+		ss.status(250, "ok")
+	case "STRAT", "STRATEGIES":
+		var lines []string
+		for _, st := range Strategies {
+			lines = append(lines, st.Name+" "+quote(st.Description))
+		}
+		ss.status(111, strconv.Itoa(len(lines))+" strategies available")
+		ss.text(strings.Join(lines, "\n"))
+		ss.status(250, "ok")
+	case "INFO":
+		if len(args) != 2 || !ss.validDB(args[1]) {
+			ss.status(550, "invalid database, use \"SHOW DB\" for list of databases")
+			return
+		}
+
+		count := 0
+		ss.Words(func(string) { count++ })
+
+		ss.status(112, "database information follows")
+		ss.text(ss.Description + "\n\n" + strconv.Itoa(count) + " words")
+		ss.status(250, "ok")
+	case "SERVER":
+		ss.status(114, "server information follows")
+		ss.text("def dict server")
+		ss.status(250, "ok")
+	default:
+		ss.status(501, "syntax error, illegal parameters")
+	}
+}
+
+// Text returns word as text of a definition,
+// vocab.Color should be off
+func Text(w *vocab.Word) string {
+	text := w.Sprintl()
+	if len(w.IPA) == 0 {
+		text = w.Word + "\n\n" + text
+	}
+
+	return text
+}
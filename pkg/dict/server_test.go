@@ -0,0 +1,65 @@
+package dict
+
+import (
+	"net"
+	"net/textproto"
+	"reflect"
+	"testing"
+
+	"github.com/nilsocket/def/pkg/vocab"
+)
+
+func TestServerMIME(t *testing.T) {
+	s := &Server{
+		Database:    "def",
+		Description: "def words",
+		Define:      func(word string) *vocab.Word { return nil },
+		Words:       func(fn func(word string)) { fn("dog") },
+	}
+
+	client, server := net.Pipe()
+	defer client.Close()
+	go s.serveConn(server)
+
+	text := textproto.NewConn(client)
+	if _, _, err := text.ReadCodeLine(220); err != nil {
+		t.Fatal(err)
+	}
+
+	match := func() []string {
+		text.PrintfLine(`MATCH def exact "dog"`)
+		if _, _, err := text.ReadCodeLine(152); err != nil {
+			t.Fatal(err)
+		}
+		lines, err := text.ReadDotLines()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := text.ReadCodeLine(250); err != nil {
+			t.Fatal(err)
+		}
+		return lines
+	}
+
+	if got, want := match(), []string{`def "dog"`}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MATCH = %q, want %q", got, want)
+	}
+
+	text.PrintfLine("OPTION MIME")
+	if _, _, err := text.ReadCodeLine(250); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"Content-type: text/plain; charset=utf-8", "", `def "dog"`}
+	if got := match(); !reflect.DeepEqual(got, want) {
+		t.Errorf("MATCH with MIME = %q, want %q", got, want)
+	}
+
+	text.PrintfLine("OPTION FOO")
+	if _, _, err := text.ReadCodeLine(501); err != nil {
+		t.Error(err)
+	}
+
+	text.PrintfLine("QUIT")
+	text.ReadCodeLine(221)
+}
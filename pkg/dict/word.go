@@ -0,0 +1,163 @@
+package dict
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/nilsocket/def/pkg/vocab"
+)
+
+// senseRe matches start of a numbered sense, at start of a line,
+// ex: "n 1: ", "2: ", "1. ", "adj 3: "
+var senseRe = regexp.MustCompile(`^(?:(n|v|adj|adv|a|s|r)\s+)?(\d+)[:.](?:\s|$)`)
+
+// headerRe matches section header lines, as written by def's own server
+var headerRe = regexp.MustCompile(`^(?:Definitions|Examples):$`)
+
+// instanceRe matches cross references, ex: "[syn: {dog}, {domestic dog}]"
+var instanceRe = regexp.MustCompile(`\[(syn|ant|also):\s*([^\]]*)\]`)
+
+// exampleRe matches quoted examples
+var exampleRe = regexp.MustCompile(`"([^"]+)"`)
+
+var classTypes = map[string]string{
+	"n": "noun", "v": "verb", "adj": "adjective", "a": "adjective",
+	"s": "adjective", "adv": "adverb", "r": "adverb",
+}
+
+var instanceTypes = map[string]string{
+	"syn": "Synonyms", "ant": "Antonyms", "also": "See also",
+}
+
+// ToWord converts definitions of word into vocab.Word,
+// each definition block is a FullDef, numbered senses within
+// a block are it's Ordinals, Short is first sense
+func ToWord(word string, defs []Definition) *vocab.Word {
+	w := &vocab.Word{Word: word}
+
+	for i, def := range defs {
+		if i == 0 && def.Word != "" {
+			w.Word = def.Word
+		}
+
+		fdef := vocab.FullDef{GroupNum: i + 1}
+
+		for j, ord := range senses(def) {
+			ord.ID = def.Database + "-" + strconv.Itoa(i+1) + "-" + strconv.Itoa(j+1)
+			fdef.Ordinals = append(fdef.Ordinals, ord)
+		}
+
+		if len(fdef.Ordinals) != 0 {
+			w.FullDefs = append(w.FullDefs, fdef)
+		}
+	}
+
+	if len(w.FullDefs) != 0 {
+		w.Short = w.FullDefs[0].Ordinals[0].Definition
+	}
+
+	return w
+}
+
+// senses of a definition block
+func senses(def Definition) []vocab.Ordinal {
+	lines := strings.Split(def.Text, "\n")
+
+	// first line is usually headword, with pronunciation
+	if len(lines) > 0 && strings.HasPrefix(strings.ToLower(strings.TrimSpace(lines[0])), strings.ToLower(def.Word)) {
+		lines = lines[1:]
+	}
+
+	var (
+		pre       []string   // lines before first numbered sense
+		numbered  [][]string // lines of each numbered sense
+		classes   []string   // class type of each numbered sense
+		classType string
+	)
+
+	// senses are numbered only at start of a line,
+	// numbers within a line are part of definition
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || headerRe.MatchString(line) {
+			continue
+		}
+
+		if loc := senseRe.FindStringSubmatchIndex(line); loc != nil {
+			if loc[2] != -1 {
+				classType = classTypes[line[loc[2]:loc[3]]]
+			}
+
+			numbered = append(numbered, []string{line[loc[1]:]})
+			classes = append(classes, classType)
+			continue
+		}
+
+		if len(numbered) == 0 {
+			pre = append(pre, line)
+		} else {
+			numbered[len(numbered)-1] = append(numbered[len(numbered)-1], line)
+		}
+	}
+
+	// not numbered, whole block is a single sense
+	if len(numbered) == 0 {
+		if len(pre) == 0 {
+			return nil
+		}
+		return []vocab.Ordinal{sense("", joinLines(pre))}
+	}
+
+	ords := make([]vocab.Ordinal, 0, len(numbered))
+	for i, sLines := range numbered {
+		ords = append(ords, sense(classes[i], joinLines(sLines)))
+	}
+
+	return ords
+}
+
+// joinLines into a single line, collapsing whitespace
+func joinLines(lines []string) string {
+	return strings.Join(strings.Fields(strings.Join(lines, " ")), " ")
+}
+
+// classRe matches class type written as "[noun] ..."
+var classRe = regexp.MustCompile(`^\[(\w+)\]\s*`)
+
+// sense parses definition, examples and cross references
+func sense(classType, text string) vocab.Ordinal {
+	if m := classRe.FindStringSubmatch(text); m != nil {
+		classType = m[1]
+		text = text[len(m[0]):]
+	}
+
+	ord := vocab.Ordinal{ClassType: classType}
+
+	for _, m := range instanceRe.FindAllStringSubmatch(text, -1) {
+		ins := vocab.Instance{Type: instanceTypes[m[1]]}
+
+		iData := vocab.InstanceData{}
+		for _, ref := range strings.Split(m[2], ",") {
+			ref = strings.Trim(strings.TrimSpace(ref), "{}")
+			if ref != "" {
+				iData.Words = append(iData.Words, ref)
+			}
+		}
+
+		ins.Datas = append(ins.Datas, iData)
+		ord.Instances = append(ord.Instances, ins)
+	}
+	text = instanceRe.ReplaceAllString(text, "")
+
+	for _, m := range exampleRe.FindAllStringSubmatch(text, -1) {
+		ord.Examples = append(ord.Examples, m[1])
+	}
+	text = exampleRe.ReplaceAllString(text, "")
+
+	// remaining braces are cross references
+	text = strings.NewReplacer("{", "", "}", "").Replace(text)
+	ord.Definition = strings.Trim(strings.TrimSpace(text), ";, ")
+
+	return ord
+}
@@ -0,0 +1,60 @@
+package dict
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestToWord(t *testing.T) {
+	defs := []Definition{{
+		Word:     "dog",
+		Database: "wn",
+		Text: "dog\n" +
+			"    n 1: a member of the genus Canis; \"the dog barked all night\"\n" +
+			"         [syn: {dog}, {domestic dog}]\n" +
+			"    2: a dull unattractive unpleasant girl or woman\n" +
+			"    v 1: go after with the intent to catch\n",
+	}, {
+		Word:     "Einstein",
+		Database: "bio",
+		Text: "Einstein\n" +
+			"  physicist, born in 1879. He developed the\n" +
+			"  theory of relativity, 2: not a sense\n",
+	}}
+
+	w := ToWord("dog", defs)
+	if len(w.FullDefs) != 2 {
+		t.Fatalf("got %d FullDefs, want 2", len(w.FullDefs))
+	}
+
+	var got [][2]string
+	for _, ord := range w.FullDefs[0].Ordinals {
+		got = append(got, [2]string{ord.ClassType, ord.Definition})
+	}
+	want := [][2]string{
+		{"noun", "a member of the genus Canis"},
+		{"noun", "a dull unattractive unpleasant girl or woman"},
+		{"verb", "go after with the intent to catch"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("senses = %q, want %q", got, want)
+	}
+
+	ord := w.FullDefs[0].Ordinals[0]
+	if !reflect.DeepEqual(ord.Examples, []string{"the dog barked all night"}) {
+		t.Errorf("examples = %q", ord.Examples)
+	}
+	if len(ord.Instances) != 1 || !reflect.DeepEqual(ord.Instances[0].Datas[0].Words, []string{"dog", "domestic dog"}) {
+		t.Errorf("instances = %+v", ord.Instances)
+	}
+
+	bio := w.FullDefs[1].Ordinals
+	wantBio := "physicist, born in 1879. He developed the theory of relativity, 2: not a sense"
+	if len(bio) != 1 || bio[0].Definition != wantBio {
+		t.Errorf("unnumbered block = %+v, want single sense %q", bio, wantBio)
+	}
+
+	if w.Short != want[0][1] {
+		t.Errorf("Short = %q, want %q", w.Short, want[0][1])
+	}
+}
@@ -0,0 +1,101 @@
+package glossary
+
+import (
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nilsocket/def/pkg/vocab"
+)
+
+// Senses ,number of definitions per word,
+// if word has no primary definitions
+var Senses = 3
+
+// Sort words alphabetically, ignoring case
+func Sort(words []*vocab.Word) {
+	sort.SliceStable(words, func(i, j int) bool {
+		return strings.ToLower(words[i].Word) < strings.ToLower(words[j].Word)
+	})
+}
+
+// Markdown returns glossary of words in markdown
+func Markdown(words []*vocab.Word) string {
+	b := &strings.Builder{}
+
+	b.WriteString("## Glossary\n")
+
+	for _, w := range words {
+		b.WriteString("\n### " + w.Word)
+		if len(w.IPA) != 0 {
+			b.WriteString(" " + strings.Join(w.IPA, ", "))
+		}
+		b.WriteString("\n\n")
+
+		if w.Short != "" {
+			b.WriteString(w.Short + "\n\n")
+		}
+
+		for i, ord := range w.Primary(Senses) {
+			b.WriteString(strconv.Itoa(i+1) + ". ")
+			if ord.ClassType != "" {
+				b.WriteString("*" + ord.ClassType + "* ")
+			}
+			b.WriteString(ord.Definition + "\n")
+		}
+
+		if ex := w.Example(); ex != "" {
+			b.WriteString("\n> " + ex + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// HTML returns glossary of words as html fragment
+func HTML(words []*vocab.Word) string {
+	b := &strings.Builder{}
+	esc := html.EscapeString
+
+	b.WriteString("<section class=\"glossary\">\n<h2>Glossary</h2>\n<dl>\n")
+
+	for _, w := range words {
+		b.WriteString("<dt id=\"" + esc(anchor(w.Word)) + "\">" + esc(w.Word))
+		if len(w.IPA) != 0 {
+			b.WriteString(" <span class=\"ipa\">" + esc(strings.Join(w.IPA, ", ")) + "</span>")
+		}
+		b.WriteString("</dt>\n<dd>\n")
+
+		if w.Short != "" {
+			b.WriteString("<p>" + esc(w.Short) + "</p>\n")
+		}
+
+		if senses := w.Primary(Senses); len(senses) != 0 {
+			b.WriteString("<ol>\n")
+			for _, ord := range senses {
+				b.WriteString("<li>")
+				if ord.ClassType != "" {
+					b.WriteString("<em>" + esc(ord.ClassType) + "</em> ")
+				}
+				b.WriteString(esc(ord.Definition) + "</li>\n")
+			}
+			b.WriteString("</ol>\n")
+		}
+
+		if ex := w.Example(); ex != "" {
+			b.WriteString("<blockquote>" + esc(ex) + "</blockquote>\n")
+		}
+
+		b.WriteString("</dd>\n")
+	}
+
+	b.WriteString("</dl>\n</section>\n")
+
+	return b.String()
+}
+
+// anchor ,ex: "ice cream" => "ice-cream"
+func anchor(word string) string {
+	return strings.ReplaceAll(strings.ToLower(word), " ", "-")
+}
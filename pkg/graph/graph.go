@@ -0,0 +1,172 @@
+package graph
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/nilsocket/def/pkg/vocab"
+)
+
+// NodeFunc returns word for given key,
+// nil if word is not available
+type NodeFunc func(word string) *vocab.Word
+
+// Edge ,relation between two words
+type Edge struct {
+	From, To string
+	Type     string // Synonyms, Antonyms, Type of, ...
+}
+
+// Graph of words related to Root
+type Graph struct {
+	Root  string
+	Nodes []string // in the order they are discovered
+	Edges []Edge
+
+	parent map[string]Edge // edge through which node is discovered
+	seen   map[string]bool
+}
+
+// Build walks relations `relTypes` of root,
+// upto depth, nodes are fetched using node
+func Build(root string, depth int, relTypes []string, node NodeFunc) *Graph {
+	g := &Graph{
+		Root:   root,
+		Nodes:  []string{root},
+		parent: map[string]Edge{},
+		seen:   map[string]bool{root: true},
+	}
+
+	edges := map[Edge]bool{}
+
+	wanted := map[string]bool{}
+	for _, relType := range relTypes {
+		wanted[vocab.RelKey(relType)] = true
+	}
+
+	// breadth first, so that first discovery
+	// of a node is through shortest path
+	level := []string{root}
+	for d := 0; d < depth && len(level) != 0; d++ {
+		var next []string
+
+		for _, word := range level {
+			vw := node(word)
+			if vw == nil {
+				continue
+			}
+
+			for _, relType := range vw.RelTypes() {
+				if !wanted[vocab.RelKey(relType)] {
+					continue
+				}
+
+				for _, to := range vw.RelWords(relType) {
+					e := Edge{From: word, To: to, Type: relType}
+					if edges[e] {
+						continue
+					}
+					edges[e] = true
+					g.Edges = append(g.Edges, e)
+
+					if !g.seen[to] {
+						g.seen[to] = true
+						g.parent[to] = e
+						g.Nodes = append(g.Nodes, to)
+						next = append(next, to)
+					}
+				}
+			}
+		}
+
+		level = next
+	}
+
+	return g
+}
+
+// Has reports whether word is part of graph
+func (g *Graph) Has(word string) bool {
+	return g.seen[word]
+}
+
+// PathTo returns shortest path of edges from Root to word,
+// nil if word isn't reachable
+func (g *Graph) PathTo(word string) []Edge {
+	if !g.seen[word] {
+		return nil
+	}
+
+	var path []Edge
+	for word != g.Root {
+		e := g.parent[word]
+		path = append([]Edge{e}, path...)
+		word = e.From
+	}
+
+	return path
+}
+
+// Sprint returns graph as a tree,
+// each node is printed only once
+func (g *Graph) Sprint() string {
+	b := &strings.Builder{}
+
+	children := map[string][]Edge{}
+	for _, node := range g.Nodes[1:] {
+		e := g.parent[node]
+		children[e.From] = append(children[e.From], e)
+	}
+
+	b.WriteString(g.Root + "\n")
+	sprintTree(g.Root, "", children, b)
+
+	return b.String()
+}
+
+func sprintTree(word, prefix string, children map[string][]Edge, b *strings.Builder) {
+	for i, e := range children[word] {
+		branch, next := "├─ ", "│  "
+		if i == len(children[word])-1 {
+			branch, next = "└─ ", "   "
+		}
+
+		b.WriteString(prefix + branch + "[" + e.Type + "] " + e.To + "\n")
+		sprintTree(e.To, prefix+next, children, b)
+	}
+}
+
+// SprintPath returns path as a string,
+// ex: dog -[Type of]-> canine
+func SprintPath(path []Edge) string {
+	if len(path) == 0 {
+		return ""
+	}
+
+	b := &strings.Builder{}
+
+	b.WriteString(path[0].From)
+	for _, e := range path {
+		b.WriteString(" -[" + e.Type + "]-> " + e.To)
+	}
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+// DOT returns graph in graphviz dot format
+func (g *Graph) DOT() string {
+	b := &strings.Builder{}
+
+	b.WriteString("digraph " + strconv.Quote(g.Root) + " {\n")
+	for _, node := range g.Nodes {
+		b.WriteString("\t" + strconv.Quote(node) + ";\n")
+	}
+	for _, e := range g.Edges {
+		b.WriteString("\t" + strconv.Quote(e.From) + " -> " + strconv.Quote(e.To) +
+			" [label=" + strconv.Quote(e.Type) + "];\n")
+	}
+	b.WriteString("}\n")
+
+	return b.String()
+}
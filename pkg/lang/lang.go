@@ -0,0 +1,86 @@
+// Package lang ,language of words, and
+// case folding as per language
+package lang
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Default language, words of it are stored without prefix
+const Default = "en"
+
+// Key of word in lang, words of languages
+// other than Default are prefixed, ex: "de:Haus"
+func Key(lang, word string) string {
+	if lang == "" || lang == Default {
+		return word
+	}
+	return lang + ":" + word
+}
+
+// Split key into lang and word, Key reversed,
+// only known language codes are split,
+// so that words like "re:read" are of Default
+func Split(key string) (string, string) {
+	i := strings.IndexByte(key, ':')
+	if i == -1 || i == len(key)-1 || !Known(key[:i]) {
+		return Default, key
+	}
+
+	return key[:i], key[i+1:]
+}
+
+// specialCases ,languages whose case mapping
+// differs from unicode defaults
+var specialCases = map[string]unicode.SpecialCase{
+	"tr": unicode.TurkishCase,
+	"az": unicode.AzeriCase,
+}
+
+// Lower case of s in lang, ex: "İSTANBUL" => "istanbul" in tr
+func Lower(lang, s string) string {
+	if c, ok := specialCases[lang]; ok {
+		return strings.ToLowerSpecial(c, s)
+	}
+	return strings.ToLower(s)
+}
+
+// Title ,lower cases s and capitalizes first letter of each word,
+// ex: "istanbul" => "İstanbul" in tr, "ijsland" => "IJsland" in nl
+func Title(lang, s string) string {
+	s = Lower(lang, s)
+
+	b := &strings.Builder{}
+	prev := ' '
+
+	for i, r := range s {
+		if unicode.IsLetter(prev) || unicode.IsDigit(prev) || prev == '\'' {
+			b.WriteRune(r)
+			prev = r
+			continue
+		}
+
+		b.WriteString(title(lang, r))
+
+		// dutch digraph, "ij" => "IJ"
+		if lang == "nl" && r == 'i' {
+			if next, _ := utf8.DecodeRuneInString(s[i+1:]); next == 'j' {
+				prev = ' '
+				continue
+			}
+		}
+
+		prev = r
+	}
+
+	return b.String()
+}
+
+func title(lang string, r rune) string {
+	if c, ok := specialCases[lang]; ok {
+		return string(c.ToTitle(r))
+	}
+	return string(unicode.ToTitle(r))
+}
@@ -0,0 +1,58 @@
+package lang
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// foldings ,letters which aren't decomposed,
+// but are written without diacritics, ex: "straße" => "strasse"
+var foldings = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'Æ': "AE", 'œ': "oe", 'Œ': "OE",
+	'ø': "o", 'Ø': "O", 'đ': "d", 'Đ': "D", 'ł': "l", 'Ł': "L",
+	'þ': "th", 'Þ': "TH", 'ı': "i",
+}
+
+// apostrophes ,written as "'"
+var apostrophes = strings.NewReplacer("’", "'", "‘", "'", "ʼ", "'")
+
+// NFC ,unicode normalization form C of s,
+// ex: "cafe\u0301" => "café"
+func NFC(s string) string {
+	return norm.NFC.String(s)
+}
+
+// Clean ,NFC form of word, with spaces collapsed,
+// "_" as space and typographic apostrophes as "'",
+// ex: " ice_cream " => "ice cream"
+func Clean(word string) string {
+	word = apostrophes.Replace(NFC(word))
+	word = strings.ReplaceAll(word, "_", " ")
+	return strings.Join(strings.Fields(word), " ")
+}
+
+// StripMarks ,removes diacritics, ex: "Café" => "Cafe"
+func StripMarks(s string) string {
+	b := &strings.Builder{}
+
+	for _, r := range norm.NFD.String(s) {
+		if f, ok := foldings[r]; ok {
+			b.WriteString(f)
+		} else if !unicode.Is(unicode.Mn, r) {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// Fold ,form of word used to match words written differently,
+// case, diacritics, hyphens and spacing are ignored,
+// ex: "Café", "cafe" => "cafe", "Well-Being" => "well being"
+func Fold(lang, word string) string {
+	word = StripMarks(Lower(lang, Clean(word)))
+	word = strings.ReplaceAll(word, "-", " ")
+	return strings.Join(strings.Fields(word), " ")
+}
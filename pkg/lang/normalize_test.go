@@ -0,0 +1,72 @@
+package lang
+
+import "testing"
+
+func TestClean(t *testing.T) {
+	tests := []struct {
+		word, want string
+	}{
+		{"cafe\u0301", "caf\u00e9"},
+		{" ice_cream ", "ice cream"},
+		{"ice   cream", "ice cream"},
+		{"don\u2019t", "don't"},
+		{"\u02bcokina", "'okina"},
+
+		// marks in either order are canonically same
+		{"e\u0323\u0302", "\u1ec7"},
+		{"e\u0302\u0323", "\u1ec7"},
+		{"\u00ea\u0323", "\u1ec7"},
+
+		// hangul jamo
+		{"\u1100\u1161", "\uac00"},
+	}
+
+	for _, tt := range tests {
+		if got := Clean(tt.word); got != tt.want {
+			t.Errorf("Clean(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		lang, word, want string
+	}{
+		{"en", "Caf\u00e9", "cafe"},
+		{"en", "cafe\u0301", "cafe"},
+		{"en", "Well-Being", "well being"},
+		{"en", "na\u00efve", "naive"},
+		{"de", "Stra\u00dfe", "strasse"},
+		{"da", "\u00c6r\u00f8", "aero"},
+		{"vi", "Vi\u1ec7t", "viet"},
+		{"vi", "Vie\u0302\u0323t", "viet"},
+		{"ru", "\u0401\u043b\u043a\u0430", "\u0435\u043b\u043a\u0430"},
+		{"tr", "\u0130STANBUL", "istanbul"},
+		{"en", "ISTANBUL", "istanbul"},
+	}
+
+	for _, tt := range tests {
+		if got := Fold(tt.lang, tt.word); got != tt.want {
+			t.Errorf("Fold(%q, %q) = %q, want %q", tt.lang, tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		key, lang, word string
+	}{
+		{"dog", Default, "dog"},
+		{"de:Haus", "de", "Haus"},
+		{"re:read", Default, "re:read"},
+		{"abc:def", Default, "abc:def"},
+		{"de:", Default, "de:"},
+		{":de", Default, ":de"},
+	}
+
+	for _, tt := range tests {
+		if l, word := Split(tt.key); l != tt.lang || word != tt.word {
+			t.Errorf("Split(%q) = %q, %q, want %q, %q", tt.key, l, word, tt.lang, tt.word)
+		}
+	}
+}
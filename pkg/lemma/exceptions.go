@@ -0,0 +1,62 @@
+package lemma
+
+// exceptions ,irregular forms => base form
+var exceptions = map[string]string{
+	// verbs
+	"am": "be", "is": "be", "are": "be", "was": "be", "were": "be", "been": "be", "being": "be",
+	"has": "have", "had": "have", "having": "have",
+	"does": "do", "did": "do", "done": "do",
+	"went": "go", "gone": "go", "goes": "go",
+	"ran": "run", "said": "say", "made": "make", "took": "take", "taken": "take",
+	"saw": "see", "seen": "see", "came": "come", "gave": "give", "given": "give",
+	"found": "find", "thought": "think", "told": "tell", "became": "become",
+	"left": "leave", "felt": "feel", "brought": "bring", "began": "begin", "begun": "begin",
+	"kept": "keep", "held": "hold", "wrote": "write", "written": "write",
+	"stood": "stand", "heard": "hear", "meant": "mean", "met": "meet", "paid": "pay",
+	"sat": "sit", "spoke": "speak", "spoken": "speak", "led": "lead",
+	"grew": "grow", "grown": "grow", "lost": "lose", "fell": "fall", "fallen": "fall",
+	"sent": "send", "built": "build", "understood": "understand",
+	"drew": "draw", "drawn": "draw", "broke": "break", "broken": "break",
+	"spent": "spend", "rose": "rise", "risen": "rise", "drove": "drive", "driven": "drive",
+	"bought": "buy", "wore": "wear", "worn": "wear", "chose": "choose", "chosen": "choose",
+	"sought": "seek", "threw": "throw", "thrown": "throw", "caught": "catch",
+	"dealt": "deal", "won": "win", "forgot": "forget", "forgotten": "forget",
+	"fought": "fight", "taught": "teach", "ate": "eat", "eaten": "eat", "fed": "feed",
+	"flew": "fly", "flown": "fly", "knew": "know", "known": "know",
+	"sang": "sing", "sung": "sing", "swam": "swim", "swum": "swim", "slept": "sleep",
+	"struck": "strike", "woke": "wake", "woken": "wake", "hid": "hide", "hidden": "hide",
+	"bit": "bite", "bitten": "bite", "shook": "shake", "shaken": "shake",
+	"stole": "steal", "stolen": "steal", "froze": "freeze", "frozen": "freeze",
+	"lay": "lie", "lain": "lie", "laid": "lay", "fled": "flee", "clung": "cling",
+	"swore": "swear", "sworn": "swear", "tore": "tear", "torn": "tear",
+	"wept": "weep", "crept": "creep", "swept": "sweep", "bent": "bend", "lent": "lend",
+	"slid": "slide", "strove": "strive", "striven": "strive", "wove": "weave", "woven": "weave",
+
+	// nouns
+	"men": "man", "women": "woman", "children": "child", "people": "person",
+	"feet": "foot", "teeth": "tooth", "geese": "goose", "mice": "mouse", "lice": "louse",
+	"oxen": "ox", "dice": "die",
+	"lives": "life", "wives": "wife", "knives": "knife", "leaves": "leaf",
+	"halves": "half", "wolves": "wolf", "shelves": "shelf", "thieves": "thief",
+	"loaves": "loaf", "calves": "calf", "selves": "self",
+	"analyses": "analysis", "crises": "crisis", "theses": "thesis", "hypotheses": "hypothesis",
+	"criteria": "criterion", "phenomena": "phenomenon",
+	"cacti": "cactus", "fungi": "fungus", "nuclei": "nucleus", "stimuli": "stimulus",
+
+	// adjectives
+	"better": "good", "best": "good", "worse": "bad", "worst": "bad",
+}
+
+// baseForms ,words which look inflected, but aren't
+var baseForms = map[string]bool{
+	"news": true, "series": true, "species": true, "bias": true, "lens": true,
+	"gas": true, "bus": true, "yes": true, "this": true, "thus": true, "his": true,
+	"its": true, "always": true, "perhaps": true, "means": true, "physics": true,
+	"mathematics": true, "politics": true, "economics": true, "athletics": true,
+	"hundred": true, "kindred": true, "sacred": true, "naked": true, "wicked": true,
+	"ragged": true, "rugged": true, "beloved": true, "crooked": true, "learned": true,
+	"ceiling": true, "pudding": true, "during": true, "morning": true, "evening": true,
+	"nothing": true, "something": true, "anything": true, "everything": true,
+	"thing": true, "king": true, "ring": true, "wing": true, "string": true,
+	"spring": true, "sibling": true, "darling": true, "herring": true, "awning": true,
+}
@@ -0,0 +1,178 @@
+package lemma
+
+import "strings"
+
+// Lemma returns base form of an english word,
+// ex: "running" => "run", "geese" => "goose",
+// irregular forms are certain, other base forms
+// are guesses, which are returned only if known,
+// otherwise word itself is returned
+func Lemma(word string, known func(string) bool) string {
+	candidates := Candidates(word)
+
+	if IsIrregular(word) {
+		return candidates[0]
+	}
+
+	if known != nil {
+		for _, c := range candidates {
+			if known(c) {
+				return c
+			}
+		}
+	}
+
+	return candidates[len(candidates)-1]
+}
+
+// IsIrregular reports whether word is an irregular form,
+// whose base form is certain, ex: "ran", "geese"
+func IsIrregular(word string) bool {
+	_, ok := exceptions[strings.ToLower(word)]
+	return ok
+}
+
+// Candidates returns possible base forms of word,
+// most likely first, word itself is the last candidate
+func Candidates(word string) []string {
+	word = strings.ToLower(word)
+
+	if base, ok := exceptions[word]; ok {
+		return []string{base, word}
+	}
+
+	if baseForms[word] {
+		return []string{word}
+	}
+
+	var candidates []string
+
+	switch {
+	case hasSuffix(word, "ies", 2):
+		candidates = append(candidates, strings.TrimSuffix(word, "ies")+"y")
+
+	case hasSuffix(word, "sses", 1):
+		candidates = append(candidates, strings.TrimSuffix(word, "es"))
+
+	case hasSuffix(word, "xes", 1), hasSuffix(word, "ches", 1), hasSuffix(word, "shes", 1), hasSuffix(word, "zes", 1):
+		candidates = append(candidates, strings.TrimSuffix(word, "es"), strings.TrimSuffix(word, "s"))
+
+	case hasSuffix(word, "s", 3) && !strings.HasSuffix(word, "ss") &&
+		!strings.HasSuffix(word, "us") && !strings.HasSuffix(word, "is"):
+		candidates = append(candidates, strings.TrimSuffix(word, "s"))
+
+	case hasSuffix(word, "ied", 2):
+		candidates = append(candidates, strings.TrimSuffix(word, "ied")+"y")
+
+	case strings.HasSuffix(word, "eed"):
+		// agreed => agree, but not need, feed
+		if hasSuffix(word, "eed", 2) {
+			candidates = append(candidates, strings.TrimSuffix(word, "d"))
+		}
+
+	case hasSuffix(word, "ed", 2):
+		candidates = append(candidates, stems(strings.TrimSuffix(word, "ed"))...)
+
+	case hasSuffix(word, "ying", 1):
+		// dying => die
+		candidates = append(candidates, strings.TrimSuffix(word, "ying")+"ie", strings.TrimSuffix(word, "ing"))
+
+	case hasSuffix(word, "ing", 2):
+		candidates = append(candidates, stems(strings.TrimSuffix(word, "ing"))...)
+	}
+
+	return append(candidates, word)
+}
+
+// stems ,possible stems after removing "ed" or "ing",
+// ex: "stopp" => "stop", "hop" => "hope", "walk" => "walk"
+func stems(stem string) []string {
+	n := len(stem)
+
+	// not a stem, ex: "br" of bring
+	if syllables(stem) == 0 {
+		return nil
+	}
+
+	// used => use, aged => age
+	if n == 2 {
+		return []string{stem + "e", stem}
+	}
+
+	// ends in consonants which can't end a word,
+	// only silent e was removed, tickled => tickle, hundred => hundre (unknown)
+	if isConsonant(stem, n-1) && isConsonant(stem, n-2) && stem[n-1] != stem[n-2] && !validEnding(stem) {
+		return []string{stem + "e"}
+	}
+
+	// doubled final consonant, stopped => stop
+	if n > 2 && stem[n-1] == stem[n-2] && isConsonant(stem, n-1) && !strings.ContainsRune("lsz", rune(stem[n-1])) {
+		return []string{stem[:n-1], stem}
+	}
+
+	// silent e, single syllable ending in consonant, vowel, consonant
+	// hoped => hope, but visited => visit
+	if cvc(stem) && syllables(stem) == 1 {
+		return []string{stem + "e", stem}
+	}
+
+	return []string{stem, stem + "e"}
+}
+
+// endings ,consonant pairs which can end a word
+var endings = []string{
+	"ck", "ch", "sh", "th", "ph", "gh", "ng", "nk", "nd", "nt", "nc", "mp", "mb",
+	"rk", "rn", "rm", "rd", "rt", "rb", "rl", "rs", "rg", "rch", "ld", "lk", "lt",
+	"lp", "lf", "lm", "lv", "sk", "sp", "st", "ft", "pt", "ct", "xt", "wn", "wl",
+	"ws", "wd", "nch", "lch", "tch", "dg", "ns", "ms", "ts", "ds",
+}
+
+func validEnding(stem string) bool {
+	for _, ending := range endings {
+		if strings.HasSuffix(stem, ending) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSuffix reports whether word ends with suffix,
+// leaving atleast min letters
+func hasSuffix(word, suffix string, min int) bool {
+	return strings.HasSuffix(word, suffix) && len(word)-len(suffix) >= min
+}
+
+func isVowel(word string, i int) bool {
+	switch word[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return true
+	case 'y':
+		return i > 0 && !isVowel(word, i-1)
+	}
+	return false
+}
+
+func isConsonant(word string, i int) bool {
+	return !isVowel(word, i)
+}
+
+// cvc reports whether word ends with consonant, vowel, consonant,
+// where last consonant isn't w, x or y
+func cvc(word string) bool {
+	n := len(word)
+	if n < 3 || strings.ContainsRune("wxy", rune(word[n-1])) {
+		return false
+	}
+	return isConsonant(word, n-3) && isVowel(word, n-2) && isConsonant(word, n-1)
+}
+
+// syllables ,approximated by groups of vowels
+func syllables(word string) int {
+	count := 0
+	for i := range word {
+		if isVowel(word, i) && (i == 0 || !isVowel(word, i-1)) {
+			count++
+		}
+	}
+	return count
+}
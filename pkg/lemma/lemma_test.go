@@ -0,0 +1,70 @@
+package lemma
+
+import "testing"
+
+func TestLemma(t *testing.T) {
+	known := map[string]bool{
+		"run": true, "stop": true, "hope": true, "walk": true, "city": true,
+		"agree": true, "box": true, "use": true, "die": true, "tickle": true,
+		"new": true, "wick": true, "ceil": true, "pud": true, "len": true,
+	}
+
+	tests := []struct {
+		word, want string
+	}{
+		// irregular
+		{"ran", "run"},
+		{"geese", "goose"},
+		{"went", "go"},
+
+		// rules, validated by known
+		{"running", "run"},
+		{"stopped", "stop"},
+		{"hoped", "hope"},
+		{"walking", "walk"},
+		{"cities", "city"},
+		{"agreed", "agree"},
+		{"boxes", "box"},
+		{"used", "use"},
+		{"dying", "die"},
+		{"tickled", "tickle"},
+
+		// look inflected, but aren't
+		{"news", "news"},
+		{"series", "series"},
+		{"species", "species"},
+		{"bias", "bias"},
+		{"lens", "lens"},
+		{"wicked", "wicked"},
+		{"ceiling", "ceiling"},
+		{"pudding", "pudding"},
+		{"hundred", "hundred"},
+
+		// unknown guesses aren't returned
+		{"flurbing", "flurbing"},
+		{"need", "need"},
+		{"bring", "bring"},
+	}
+
+	for _, tt := range tests {
+		if got := Lemma(tt.word, func(w string) bool { return known[w] }); got != tt.want {
+			t.Errorf("Lemma(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestLemmaUnknown(t *testing.T) {
+	tests := []struct {
+		word, want string
+	}{
+		{"ran", "run"},
+		{"running", "running"},
+		{"species", "species"},
+	}
+
+	for _, tt := range tests {
+		if got := Lemma(tt.word, nil); got != tt.want {
+			t.Errorf("Lemma(%q, nil) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package stardict
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"sync"
+)
+
+// gzip header flags
+const (
+	fhcrc    = 1 << 1
+	fextra   = 1 << 2
+	fname    = 1 << 3
+	fcomment = 1 << 4
+)
+
+// errNoChunks ,gzip file has no dictzip chunk table
+var errNoChunks = errors.New("stardict: not a dictzip file")
+
+// dictzip ,random access reader of a .dict.dz file,
+// data is gzipped in chunks of chunkLen bytes, each chunk
+// can be inflated on it's own, only chunks read are inflated
+type dictzip struct {
+	f        *os.File
+	chunkLen int64
+	offsets  []int64 // offset of each compressed chunk in file, and end of last one
+
+	mu       sync.Mutex
+	cached   int // index of cached chunk, -1 if none
+	cacheBuf []byte
+}
+
+// openDictzip opens path, errNoChunks is returned
+// if it's a plain gzip file
+func openDictzip(path string) (*dictzip, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	dz := &dictzip{f: f, cached: -1}
+	if err := dz.readHeader(); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return dz, nil
+}
+
+// readHeader reads chunk table from "RA" subfield of gzip header,
+// subfield data is version (1), chunk length, chunk count,
+// and compressed size of each chunk, little endian 16 bit values
+func (dz *dictzip) readHeader() error {
+	r := bufio.NewReader(dz.f)
+	pos := int64(0)
+
+	read := func(n int) ([]byte, error) {
+		b := make([]byte, n)
+		_, err := io.ReadFull(r, b)
+		pos += int64(n)
+		return b, err
+	}
+
+	skipString := func() error {
+		s, err := r.ReadBytes(0)
+		pos += int64(len(s))
+		return err
+	}
+
+	h, err := read(10)
+	if err != nil {
+		return err
+	}
+	if h[0] != 0x1f || h[1] != 0x8b || h[2] != 8 {
+		return errors.New("stardict: invalid gzip header")
+	}
+
+	flags := h[3]
+	if flags&fextra == 0 {
+		return errNoChunks
+	}
+
+	xlen, err := read(2)
+	if err != nil {
+		return err
+	}
+
+	extra, err := read(int(binary.LittleEndian.Uint16(xlen)))
+	if err != nil {
+		return err
+	}
+
+	var sizes []byte
+	for len(extra) >= 4 {
+		n := int(binary.LittleEndian.Uint16(extra[2:]))
+		if len(extra) < 4+n {
+			break
+		}
+
+		if extra[0] == 'R' && extra[1] == 'A' {
+			sizes = extra[4 : 4+n]
+			break
+		}
+		extra = extra[4+n:]
+	}
+
+	if len(sizes) < 6 || binary.LittleEndian.Uint16(sizes) != 1 {
+		return errNoChunks
+	}
+
+	dz.chunkLen = int64(binary.LittleEndian.Uint16(sizes[2:]))
+	count := int(binary.LittleEndian.Uint16(sizes[4:]))
+	sizes = sizes[6:]
+	if dz.chunkLen == 0 || len(sizes) < 2*count {
+		return errors.New("stardict: corrupt dictzip chunk table")
+	}
+
+	if flags&fname != 0 {
+		if err := skipString(); err != nil {
+			return err
+		}
+	}
+	if flags&fcomment != 0 {
+		if err := skipString(); err != nil {
+			return err
+		}
+	}
+	if flags&fhcrc != 0 {
+		if _, err := read(2); err != nil {
+			return err
+		}
+	}
+
+	dz.offsets = make([]int64, count+1)
+	dz.offsets[0] = pos
+	for i := 0; i < count; i++ {
+		dz.offsets[i+1] = dz.offsets[i] + int64(binary.LittleEndian.Uint16(sizes[2*i:]))
+	}
+
+	return nil
+}
+
+// ReadAt reads uncompressed data at off
+func (dz *dictzip) ReadAt(p []byte, off int64) (int, error) {
+	dz.mu.Lock()
+	defer dz.mu.Unlock()
+
+	n := 0
+	for n < len(p) {
+		i := int((off + int64(n)) / dz.chunkLen)
+		if i >= len(dz.offsets)-1 {
+			return n, io.EOF
+		}
+
+		chunk, err := dz.chunk(i)
+		if err != nil {
+			return n, err
+		}
+
+		start := off + int64(n) - int64(i)*dz.chunkLen
+		if start >= int64(len(chunk)) {
+			return n, io.EOF
+		}
+
+		n += copy(p[n:], chunk[start:])
+	}
+
+	return n, nil
+}
+
+// chunk i, inflated
+func (dz *dictzip) chunk(i int) ([]byte, error) {
+	if dz.cached == i {
+		return dz.cacheBuf, nil
+	}
+
+	compressed := make([]byte, dz.offsets[i+1]-dz.offsets[i])
+	if _, err := dz.f.ReadAt(compressed, dz.offsets[i]); err != nil {
+		return nil, err
+	}
+
+	// chunks other than last end with a full flush,
+	// not end of stream
+	b := make([]byte, dz.chunkLen)
+	n, err := io.ReadFull(flate.NewReader(bytes.NewReader(compressed)), b)
+	if err != nil && !((err == io.ErrUnexpectedEOF || err == io.EOF) && i == len(dz.offsets)-2) {
+		return nil, err
+	}
+
+	dz.cached, dz.cacheBuf = i, b[:n]
+	return dz.cacheBuf, nil
+}
+
+// Close file
+func (dz *dictzip) Close() error {
+	return dz.f.Close()
+}
@@ -0,0 +1,110 @@
+package stardict
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeDictzip writes data as a dictzip file,
+// deflating each chunk of chunkLen bytes separately
+func writeDictzip(t *testing.T, path string, data []byte, chunkLen int) {
+	var chunks [][]byte
+	for off := 0; off < len(data); off += chunkLen {
+		end := off + chunkLen
+		if end > len(data) {
+			end = len(data)
+		}
+
+		var c bytes.Buffer
+		w, _ := flate.NewWriter(&c, flate.BestCompression)
+		w.Write(data[off:end])
+		if end == len(data) {
+			w.Close()
+		} else {
+			w.Flush()
+		}
+		chunks = append(chunks, c.Bytes())
+	}
+
+	le16 := func(b *bytes.Buffer, v int) {
+		binary.Write(b, binary.LittleEndian, uint16(v))
+	}
+
+	ra := &bytes.Buffer{}
+	le16(ra, 1)
+	le16(ra, chunkLen)
+	le16(ra, len(chunks))
+	for _, c := range chunks {
+		le16(ra, len(c))
+	}
+
+	f := &bytes.Buffer{}
+	f.Write([]byte{0x1f, 0x8b, 8, fextra | fname, 0, 0, 0, 0, 2, 3})
+	le16(f, 4+ra.Len())
+	f.WriteString("RA")
+	le16(f, ra.Len())
+	f.Write(ra.Bytes())
+	f.WriteString("test.dict\x00")
+	for _, c := range chunks {
+		f.Write(c)
+	}
+	binary.Write(f, binary.LittleEndian, crc32.ChecksumIEEE(data))
+	binary.Write(f, binary.LittleEndian, uint32(len(data)))
+
+	if err := ioutil.WriteFile(path, f.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDictzip(t *testing.T) {
+	var sb strings.Builder
+	for i := 0; sb.Len() < 5000; i++ {
+		sb.WriteString("article number " + string(rune('a'+i%26)) + " of test dictionary\n")
+	}
+	data := []byte(sb.String())
+
+	path := filepath.Join(t.TempDir(), "test.dict.dz")
+	writeDictzip(t, path, data, 1000)
+
+	// also a valid gzip file
+	if b, err := readGzip(path); err != nil || !bytes.Equal(b, data) {
+		t.Fatalf("readGzip = %d bytes, %v", len(b), err)
+	}
+
+	dz, err := openDictzip(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dz.Close()
+
+	tests := []struct{ off, size int }{
+		{0, 10},
+		{995, 10},    // across chunks
+		{1500, 2600}, // across several chunks
+		{4990, 10},   // last chunk
+		{0, len(data)},
+	}
+
+	for _, tt := range tests {
+		b := make([]byte, tt.size)
+		if _, err := dz.ReadAt(b, int64(tt.off)); err != nil {
+			t.Errorf("ReadAt(%d, %d): %v", tt.off, tt.size, err)
+			continue
+		}
+		if !bytes.Equal(b, data[tt.off:tt.off+tt.size]) {
+			t.Errorf("ReadAt(%d, %d) = %q", tt.off, tt.size, b)
+		}
+	}
+
+	b := make([]byte, 10)
+	if n, err := dz.ReadAt(b, int64(len(data)-5)); n != 5 || err != io.EOF {
+		t.Errorf("ReadAt past end = %d, %v, want 5, EOF", n, err)
+	}
+}
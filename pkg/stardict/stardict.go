@@ -0,0 +1,335 @@
+// Package stardict reads StarDict dictionaries,
+// (.ifo, .idx[.gz], .dict[.dz])
+package stardict
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const ifoMagic = "StarDict's dict ifo file"
+
+// ErrNotStarDict is returned if .ifo file is invalid
+var ErrNotStarDict = errors.New("not a stardict .ifo file")
+
+// Dict ,StarDict dictionary
+type Dict struct {
+	BookName  string
+	WordCount int
+
+	sameTypeSeq string
+	entries     []idxEntry
+	index       map[string][]int // lowercased word to entries
+	data        io.ReaderAt
+	closer      io.Closer
+}
+
+type idxEntry struct {
+	word   string
+	offset uint64
+	size   uint32
+}
+
+// Entry ,an article of dictionary
+type Entry struct {
+	Word     string
+	Phonetic string // 't' field
+	Text     string // text fields, markup removed
+}
+
+// Open dictionary given path to it's .ifo file,
+// .idx and .dict files are expected beside it
+func Open(ifoPath string) (*Dict, error) {
+	d := &Dict{index: map[string][]int{}}
+
+	offsetBits, err := d.readIfo(ifoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	base := strings.TrimSuffix(ifoPath, ".ifo")
+
+	if err := d.readIdx(base, offsetBits); err != nil {
+		return nil, err
+	}
+
+	if err := d.openData(base); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// readIfo reads metadata, returns bits used by offsets in .idx
+func (d *Dict) readIfo(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	if !sc.Scan() || strings.TrimPrefix(strings.TrimSpace(sc.Text()), "\ufeff") != ifoMagic {
+		return 0, ErrNotStarDict
+	}
+
+	offsetBits := 32
+
+	for sc.Scan() {
+		kv := strings.SplitN(strings.TrimSpace(sc.Text()), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+
+		switch kv[0] {
+		case "bookname":
+			d.BookName = kv[1]
+		case "wordcount":
+			d.WordCount, _ = strconv.Atoi(kv[1])
+		case "sametypesequence":
+			d.sameTypeSeq = kv[1]
+		case "idxoffsetbits":
+			offsetBits, _ = strconv.Atoi(kv[1])
+		}
+	}
+
+	if offsetBits != 32 && offsetBits != 64 {
+		return 0, fmt.Errorf("unsupported idxoffsetbits %d", offsetBits)
+	}
+
+	return offsetBits, sc.Err()
+}
+
+// readIdx reads index, each entry is
+// word\0, offset (32 or 64 bits), size (32 bits), big endian
+func (d *Dict) readIdx(base string, offsetBits int) error {
+	b, err := readMaybeGzip(base+".idx", base+".idx.gz")
+	if err != nil {
+		return err
+	}
+
+	offsetLen := offsetBits / 8
+
+	for len(b) > 0 {
+		i := bytes.IndexByte(b, 0)
+		if i == -1 || len(b) < i+1+offsetLen+4 {
+			return errors.New("stardict: corrupt .idx file")
+		}
+
+		e := idxEntry{word: string(b[:i])}
+		b = b[i+1:]
+
+		if offsetLen == 8 {
+			e.offset = binary.BigEndian.Uint64(b)
+		} else {
+			e.offset = uint64(binary.BigEndian.Uint32(b))
+		}
+		e.size = binary.BigEndian.Uint32(b[offsetLen:])
+		b = b[offsetLen+4:]
+
+		key := strings.ToLower(e.word)
+		d.index[key] = append(d.index[key], len(d.entries))
+		d.entries = append(d.entries, e)
+	}
+
+	return nil
+}
+
+// openData opens .dict file, chunks of .dict.dz (dictzip)
+// are read on demand, a .dict.dz without chunk table,
+// i.e., plain gzip, is decompressed into memory
+func (d *Dict) openData(base string) error {
+	if f, err := os.Open(base + ".dict"); err == nil {
+		d.data, d.closer = f, f
+		return nil
+	}
+
+	dz, err := openDictzip(base + ".dict.dz")
+	if err == nil {
+		d.data, d.closer = dz, dz
+		return nil
+	}
+	if err != errNoChunks {
+		return err
+	}
+
+	b, err := readGzip(base + ".dict.dz")
+	if err != nil {
+		return err
+	}
+
+	d.data = bytes.NewReader(b)
+	return nil
+}
+
+// Close dictionary
+func (d *Dict) Close() error {
+	if d.closer != nil {
+		return d.closer.Close()
+	}
+	return nil
+}
+
+// Lookup entries of word, ignoring case,
+// exact case matches come first
+func (d *Dict) Lookup(word string) ([]Entry, error) {
+	var entries, rest []Entry
+
+	for _, i := range d.index[strings.ToLower(word)] {
+		ie := d.entries[i]
+
+		b := make([]byte, ie.size)
+		if _, err := d.data.ReadAt(b, int64(ie.offset)); err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		e := d.parse(b)
+		e.Word = ie.word
+
+		if ie.word == word {
+			entries = append(entries, e)
+		} else {
+			rest = append(rest, e)
+		}
+	}
+
+	return append(entries, rest...), nil
+}
+
+// Words calls fn for each headword of dictionary
+func (d *Dict) Words(fn func(word string)) {
+	for _, e := range d.entries {
+		fn(e.word)
+	}
+}
+
+// parse article data, fields are typed by sametypesequence,
+// or else each field is prefixed by it's type,
+// lower case types are '\0' terminated,
+// upper case types are prefixed by 32 bit size
+func (d *Dict) parse(b []byte) Entry {
+	var e Entry
+	var texts []string
+
+	add := func(typ byte, field []byte) {
+		switch typ {
+		case 't':
+			e.Phonetic = string(field)
+		case 'm', 'l', 'y':
+			texts = append(texts, string(field))
+		case 'g', 'h', 'x':
+			texts = append(texts, stripMarkup(string(field)))
+		}
+	}
+
+	if d.sameTypeSeq != "" {
+		seq := d.sameTypeSeq
+		for i := 0; i < len(seq) && len(b) > 0; i++ {
+			var field []byte
+			field, b = next(seq[i], b, i == len(seq)-1)
+			add(seq[i], field)
+		}
+	} else {
+		for len(b) > 0 {
+			typ := b[0]
+			var field []byte
+			field, b = next(typ, b[1:], false)
+			add(typ, field)
+		}
+	}
+
+	e.Text = strings.TrimSpace(strings.Join(texts, "\n"))
+	return e
+}
+
+// next field of type typ in b, and remaining data,
+// last field of sametypesequence takes the rest
+func next(typ byte, b []byte, last bool) ([]byte, []byte) {
+	if last {
+		return b, nil
+	}
+
+	if typ >= 'a' && typ <= 'z' {
+		i := bytes.IndexByte(b, 0)
+		if i == -1 {
+			return b, nil
+		}
+		return b[:i], b[i+1:]
+	}
+
+	if len(b) < 4 {
+		return nil, nil
+	}
+
+	size := int(binary.BigEndian.Uint32(b))
+	b = b[4:]
+	if size > len(b) {
+		size = len(b)
+	}
+
+	return b[:size], b[size:]
+}
+
+// Find .ifo files in paths,
+// a path can be a .ifo file or a directory containing them
+func Find(paths []string) []string {
+	var ifos []string
+
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+
+		if !fi.IsDir() {
+			ifos = append(ifos, path)
+			continue
+		}
+
+		filepath.Walk(path, func(p string, fi os.FileInfo, err error) error {
+			if err == nil && !fi.IsDir() && filepath.Ext(p) == ".ifo" {
+				ifos = append(ifos, p)
+			}
+			return nil
+		})
+	}
+
+	return ifos
+}
+
+// readMaybeGzip reads plain file if it exists,
+// otherwise gzipped one
+func readMaybeGzip(plain, gz string) ([]byte, error) {
+	b, err := ioutil.ReadFile(plain)
+	if err == nil || !os.IsNotExist(err) {
+		return b, err
+	}
+
+	return readGzip(gz)
+}
+
+func readGzip(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return ioutil.ReadAll(r)
+}
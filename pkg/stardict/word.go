@@ -0,0 +1,68 @@
+package stardict
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/nilsocket/def/pkg/vocab"
+)
+
+// breakRe matches tags which end a line
+var breakRe = regexp.MustCompile(`(?i)<br\s*/?>|</(p|div|li|tr|h\d)>`)
+
+var tagRe = regexp.MustCompile(`<[^>]*>`)
+
+// numRe matches numbering of a sense, ex: "1. ", "2) ", "(3) "
+var numRe = regexp.MustCompile(`^\(?\d+[.)]\s*`)
+
+// stripMarkup of html, pango or xdxf text
+func stripMarkup(s string) string {
+	s = breakRe.ReplaceAllString(s, "\n")
+	s = tagRe.ReplaceAllString(s, "")
+	return html.UnescapeString(s)
+}
+
+// ToWord converts entries of word into vocab.Word,
+// each entry is a FullDef, and each line of it an Ordinal,
+// Short is first line
+func ToWord(word string, entries []Entry) *vocab.Word {
+	w := &vocab.Word{Word: word}
+
+	for i, e := range entries {
+		if i == 0 {
+			w.Word = e.Word
+		}
+
+		if e.Phonetic != "" {
+			w.IPA = append(w.IPA, e.Phonetic)
+		}
+
+		fdef := vocab.FullDef{GroupNum: i + 1}
+
+		for _, line := range strings.Split(e.Text, "\n") {
+			line = strings.TrimSpace(numRe.ReplaceAllString(strings.TrimSpace(line), ""))
+
+			// headword is usually repeated
+			if line == "" || strings.EqualFold(line, e.Word) {
+				continue
+			}
+
+			fdef.Ordinals = append(fdef.Ordinals, vocab.Ordinal{
+				ID:         "sd" + strconv.Itoa(i+1) + "-" + strconv.Itoa(len(fdef.Ordinals)+1),
+				Definition: line,
+			})
+		}
+
+		if len(fdef.Ordinals) != 0 {
+			w.FullDefs = append(w.FullDefs, fdef)
+		}
+	}
+
+	if len(w.FullDefs) != 0 {
+		w.Short = w.FullDefs[0].Ordinals[0].Definition
+	}
+
+	return w
+}
@@ -0,0 +1,934 @@
+package text
+
+import "strings"
+
+// commonWords ,10000 most frequent english words, most frequent first,
+// from Wiktionary frequency list of english as used in
+// television and movies (https://en.wiktionary.org/wiki/Wiktionary:Frequency_lists),
+// as distributed by zxcvbn, words with apostrophes are omitted
+var commonWords = strings.Fields(`
+you i to the a and that it of me what is in this know for no have my just not do be on
+your was we with so but all well are he oh about right get here out going like yeah if her
+she can up want think now go him at how got there one did why see come good they really as
+would look when time will okay back mean tell from hey were could yes his been or
+something who because some had then say ok take an way us little make need gonna never too
+sure them more over our sorry where let thing am maybe down man has uh very by should
+anything said much any life even off doing thank give only thought help two talk people
+god still wait into find nothing again things call told great before better ever night
+than away first believe other feel everything work fine home after last these day keep
+does put around stop guy always listen wanted mr guys huh those big lot happened thanks
+trying kind wrong through talking made new being guess hi care bad mom remember getting
+together dad leave place understand actually hear baby nice father else stay done their
+course might mind every enough try hell came someone own family whole another house
+yourself idea ask best must coming old looking woman which years room left knew tonight
+real son hope name same went um hmm happy pretty saw girl sir show friend already saying
+next three job problem minute found world thinking heard honey matter myself exactly
+having ah probably happen hurt boy both while dead gotta alone since excuse start kill
+hard today car ready until without wants hold wanna yet seen deal took once gone called
+morning supposed friends head stuff most used worry second part live truth school face
+forget true business each cause soon knows few telling wife use chance run move anyone
+person bye somebody dr heart such miss married point later making meet anyway many phone
+reason damn lost looks bring case turn wish tomorrow kids trust check change end late
+anymore five least town ha working year makes taking means brother play hate ago says
+beautiful gave fact crazy party sit open afraid between important rest fun kid word watch
+glad everyone days sister minutes everybody bit couple whoa either mrs feeling daughter
+wow gets asked under break promise door set close hand easy question tried far walk needs
+mine though times different killed hospital anybody alright wedding shut able die perfect
+stand comes hit story ya mm waiting dinner against funny husband almost pay answer four
+office eyes news child half side yours moment sleep read started men sounds sonny pick
+sometimes em bed also date line plan hours lose hands serious behind inside high ahead
+week wonderful fight past cut quite number sick game eat nobody goes along save seems
+finally lives worried upset carly met book brought seem sort safe living children leaving
+front shot loved asking running clear figure hot felt six parents drink absolutely daddy
+alive sense meant happens special bet blood kidding lie full meeting dear seeing sound
+fault water ten women buy months hour speak lady jen thinks christmas body order outside
+hang possible worse company mistake ooh handle spend totally giving control marriage
+realize president unless sex send needed taken died scared picture talked ass hundred
+changed completely explain playing certainly sign boys relationship loves hair lying
+choice anywhere future weird luck turned known touch kiss crane questions obviously wonder
+pain calling somewhere throw straight cold fast words food none drive feelings worked
+marry light drop cannot sent city dream protect twenty class surprise its sweetheart poor
+looked mad except gun dance takes appreciate especially situation besides pull himself act
+worth sheridan amazing top given expect rather involved swear piece busy law decided
+happening movie catch country less perhaps step fall watching kept darling dog win air
+honor personal moving till admit problems murder evil definitely feels information honest
+eye broke missed longer dollars tired evening human starting red entire trip club niles
+suppose calm imagine fair caught blame street sitting favor apartment court terrible clean
+learn works frasier relax million accident wake prove smart message missing forgot
+interested table nbsp become mouth pregnant middle ring careful shall team ride figured
+wear shoot stick follow angry instead write stopped early ran war standing forgive jail
+wearing kinda lunch cristian eight greenlee gotten hoping phoebe thousand ridge paper
+tough tape state count boyfriend proud agree birthday seven history share offer hurry feet
+wondering decision building ones finish voice herself list mess deserve evidence cute
+dress interesting hotel quiet concerned road staying beat sweetie mention clothes finished
+fell neither mmm fix respect spent prison attention holding calls near surprised bar
+keeping gift putting dark self owe using ice helping normal aunt lawyer apart certain
+plans jax girlfriend floor whether present earth box cover judge upstairs sake mommy
+possibly worst station acting accept blow strange saved conversation plane mama yesterday
+lied quick lately stuck report difference rid store bag bought doubt listening walking
+cops deep dangerous buffy sleeping chloe rafe shh record lord moved join card crime
+gentlemen willing window return walked guilty likes fighting difficult soul joke favorite
+uncle promised public bother island seriously cell lead knowing broken advice somehow paid
+losing push helped killing usually earlier boss beginning liked innocent doc rules cop
+learned thirty risk letting speaking officer ridiculous support afternoon born apologize
+seat nervous across song charge patient boat hide detective planning nine huge breakfast
+horrible age awful pleasure driving hanging picked sell quit apparently dying notice
+congratulations chief month visit letter decide double sad press forward fool showed smell
+seemed spell memory pictures slow seconds hungry board position hearing roz kitchen force
+fly during space realized experience kick others grab discuss third cat fifty responsible
+fat reading idiot yep suddenly agent destroy bucks track shoes scene peace arms demon low
+livvie consider papers medical incredible witch drunk attorney tells knock ways gives
+department nose skye turns keeps jealous drug sooner cares plenty extra tea won attack
+ground whose outta weekend matters wrote type gosh opportunity impossible books waste
+pretend named jump eating proof complete slept career arrest breathe perfectly warm pulled
+twice easier goin dating suit romantic drugs comfortable finds checked fit divorce begin
+ourselves closer ruin although smile laugh treat fear otherwise excited mail hiding cost
+stole pacey noticed fired excellent lived bringing pop bottom note sudden bathroom flight
+honestly sing foot games remind bank charges witness finding places tree dare hardly
+interest steal silly contact teach shop plus colonel fresh trial invited roll radio reach
+heh choose emergency dropped credit obvious cry locked loving positive nuts agreed prue
+goodbye condition guard fuckin grow cake mood total crap crying belong lay partner trick
+pressure ohh arm dressed cup lies bus taste neck south nurse raise lots carry group
+whoever drinking breaking file lock wine closed writing spot paying study assume asleep
+turning legal viki bedroom shower nikolas camera fill reasons forty bigger nope breath
+doctors pants level movies gee area folks ugh continue focus wild truly desk convince
+client threw band hurts spending allow grand answers shirt chair allowed rough doin sees
+government ought empty round hat wind shows aware dealing pack meaning hurting ship
+subject guest pal match arrested salem confused surgery expecting deacon unfortunately
+goddamn lab passed bottle beyond whenever pool opinion held common starts jerk secrets
+falling played necessary barely dancing health tests copy cousin planned dry ahem twelve
+simply tess skin often fifteen speech names issue orders nah final results code believed
+complicated umm research nowhere escape biggest restaurant grateful usual burn address
+within someplace screw everywhere train film regret goodness mistakes details
+responsibility suspect corner hero dumb terrific further gas whoo hole memories following
+ended teeth ruined split airport bite stenbeck older liar showing project cards desperate
+themselves pathetic damage spoke quickly scare marah afford vote settle mentioned due
+stayed rule checking tie hired upon heads concern blew natural alcazar champagne
+connection tickets happiness form saving kissing hated personally suggest prepared build
+leg onto leaves downstairs ticket taught loose holy staff sea duty convinced throwing
+defense kissed legs according loud practice saturday babies army warning miracle carrying
+flying blind ugly shopping hates sight bride coat account states clearly celebrate
+brilliant wanting add forrester lips custody center screwed buying size toast thoughts
+student stories however professional reality birth lexie attitude advantage grandfather
+sami sold opened grandma beg changes someday grade roof brothers signed ahh marrying
+powerful grown grandmother fake opening expected eventually ideas exciting covered
+familiar bomb bout television harmony color heavy schedule records capable practically
+including correct clue forgotten immediately appointment social nature deserves threat
+bloody lonely ordered shame local jacket hook destroyed scary investigation above invite
+shooting port lesson criminal growing caused victim professor followed funeral considering
+burning strength loss view gia sisters several pushed written shock pushing heat chocolate
+greatest miserable corinthos nightmare brings zander character became famous enemy crash
+chances sending recognize healthy boring feed engaged percent headed lines treated purpose
+knife rights drag san fan badly hire paint pardon built behavior closet warn gorgeous milk
+survive forced operation offered ends dump rent remembered lieutenant trade thanksgiving
+rain revenge physical available program prefer spare pray disappeared aside statement
+sometime meat fantastic breathing laughing itself tip stood market affair ours depends
+main protecting jury national brave large interview fingers murdered explanation process
+picking based style pieces blah assistant stronger aah pie handsome unbelievable anytime
+nearly shake oakdale cars wherever serve pulling points medicine facts waited lousy
+circumstances stage disappointed weak trusted license nothin community trash understanding
+slip cab sounded awake friendship stomach weapon threatened mystery official regular river
+vegas understood contract race basically switch frankly issues cheap lifetime deny
+painting ear clock weight garbage tear ears dig selling setting indeed changing singing
+tiny particular draw decent avoid messed filled touched score disappear exact pills kicked
+harm recently fortune pretending raised insurance fancy drove cared belongs nights shape
+lorelai base lift stock fashion timing guarantee chest bridge woke source patients theory
+original burned watched heading selfish oil drinks failed period doll committed elevator
+freeze noise exist science pair edge wasting sat ceremony pig uncomfortable peg guns
+staring files bike weather mostly stress permission arrived thrown possibility example
+borrow release ate notes hoo library property negative fabulous event doors screaming
+xander term meal fellow apology anger honeymoon wet bail parking non protection fixed
+families chinese campaign map wash stolen sensitive stealing chose lets comfort worrying
+whom pocket mateo bleeding students shoulder ignore fourth neighborhood fbi talent tied
+garage dies demons dumped witches training rude crack model bothering radar grew remain
+soft meantime gimme connected kinds cast sky likely fate buried hug concentrate prom
+messages east unit intend crew ashamed somethin manage guilt weapons terms interrupt guts
+tongue distance conference treatment shoe basement sentence purse glasses cabin universe
+towards repeat mirror wound travers tall reaction odd engagement therapy letters emotional
+runs magazine jeez decisions soup thrilled society managed stake chef moves extremely
+entirely moments expensive counting shots kidnapped square cleaning shift plate impressed
+smells trapped male tour aidan knocked charming attractive argue puts whip language
+embarrassed settled package laid animals hitting disease bust stairs alarm pure nail nerve
+incredibly walks dirt stamp becoming terribly friendly easily damned jobs suffering
+disgusting stopping deliver riding helps federal disaster bars dna crossed rate create
+trap claim california talks eggs effect chick threatening spoken introduce confession
+embarrassing bags impression gate reputation attacked among knowledge presents inn europe
+chat suffer argument talkin crowd homework fought coincidence cancel accepted rip pride
+solve hopefully pounds pine mate illegal generous streets con separate outfit maid bath
+punch mayor freaked begging recall enjoying bug prepare parts wheel signal direction
+defend signs painful yourselves rat maris amount suspicious flat cooking button warned
+sixty pity parties crisis coach row yelling leads awhile pen confidence offering falls
+image farm pleased panic hers gettin role refuse determined grandpa progress testify
+passing military choices uhh gym cruel wings bodies mental gentleman coma cutting proteus
+guests expert benefit faces cases led jumped toilet secretary sneak mix firm halloween
+agreement privacy dates anniversary smoking reminds pot created twins swing successful
+season scream considered solid options commitment senior ill crush ambulance wallet
+discovered officially til rise reached eleven option laundry former assure stays skip fail
+accused wide challenge popular learning discussion clinic plant exchange betrayed bro
+sticking university members lower bored mansion soda sheriff suite handled busted senator
+load happier younger studying romance procedure ocean section sec commit assignment
+suicide minds swim ending bat yell llanview league chasing seats proper command believes
+humor hopes fifth winning solution leader sale lawyers nor material latest highly escaped
+audience parent tricks insist dropping cheer medication higher flesh district routine
+century shared sandwich handed false beating appear warrant awfully odds article treating
+thin suggesting fever sweat silent specific clever sweater request prize mall tries mile
+fully estate union sharing assuming judgment goodnight divorced despite surely steps jet
+confess math listened comin answered vulnerable bless dreaming rooms chip zero potential
+pissed nate kills tears knees chill brains agency harvard degree unusual joint packed
+dreamed cure covering newspaper lookin coast grave egg direct cheating breaks quarter
+mixed locker gifts awkward toy thursday rare policy joking competition classes assumed
+reasonable dozen curse quartermaine millions dessert rolling detail alien served delicious
+closing vampires released ancient wore value tail secure salad murderer hits toward spit
+screen offense dust conscience bread answering admitted lame invitation grief smiling path
+stands bowl pregnancy hollywood prisoner delivery guards virus shrink influence freezing
+concert wreck partners massimo chain birds wire technically presence blown anxious cave
+version holidays cleared wishes survived caring candles bound related charm yup pulse
+jumping jokes frame boom vice performance occasion silence opera nonsense frightened
+downtown americans slipped dimera blowing session relationships kidnapping actual spin
+civil roxy packing education blaming wrap obsessed fruit torture personality location
+effort commander trees owner fairy per necessarily county contest seventy print motel
+fallen directly underwear grams exhausted believing particularly freaking carefully trace
+touching messing committee recovery intention consequences belt sacrifice courage officers
+enjoyed lack attracted appears bay yard returned remove nut carried testimony intense
+granted violence heal defending attempt unfair relieved political loyal approach slowly
+plays normally buzz alcohol actor surprises psychiatrist pre plain attic uniform terrified
+sons pet cleaned zach threaten teaching mum motion fella enemies desert collection
+incident failure satisfied imagination hooked headache forgetting counselor andie acted
+opposite highest equipment badge italian visiting naturally frozen commissioner sakes
+labor appropriate trunk armed thousands received dunno costume temporary sixteen
+impressive zone kicking junk hon grabbed unlike understands describe clients owns affect
+witnesses starving instincts happily discussing deserved strangers leading intelligence
+host authority surveillance cow commercial admire questioning fund dragged barn object
+deeply amp wrapped wasted tense route reports hoped fellas election roommate mortal
+fascinating chosen stops shown arranged abandoned sides delivered becomes arrangements
+agenda began theater series literally propose honesty underneath forces services sauce
+promises lecture eighty torn shocked relief explained counter circle victims transfer
+response channel identity differently campus spy ninety interests guide deck biological
+pheebs ease creep waitress skills telephone ripped raising scratch rings prints wave thee
+arguing figures ephram asks reception pin oops diner annoying agents taggert goal mass
+ability sergeant international gig blast basic tradition towel earned rub habit customers
+creature bermuda actions snap react prime paranoid wha handling eaten therapist comment
+charged tax sink reporter beats priority interrupting gain fed warehouse shy pattern
+loyalty inspector events pleasant media excuses threats permanent guessing financial
+demand assault tend praying motive los unconscious trained museum tracks range nap
+mysterious unhappy tone switched rappaport award sookie neighbor loaded gut childhood
+causing swore piss hundreds balance background toss mob misery thief squeeze lobby hah
+geez exercise ego drama forth facing booked boo songs sandburg eighteen bury perform
+everyday digging creepy compared wondered trail liver hmmm drawn device magical journey
+fits discussed supply moral helpful attached searching flew depressed aisle underground
+pro daughters cris amen vows proposal pit neighbors darn cents arrange annulment uses
+useless squad represent product joined afterwards adventure resist protected net fourteen
+celebrating piano inch flag debt violent tag sand gum dammit hip celebration below
+reminded claims replace phones paperwork emotions typical stubborn stable pound papa lap
+designed current bum tension tank suffered steady provide overnight meanwhile chips beef
+wins suits boxes salt cassadine collect tragedy therefore spoil realm profile degrees wipe
+surgeon stretch stepped nephew neat limo confident anti perspective designer climb title
+suggested punishment finest springfield occurred hint furniture blanket twist surrounded
+surface proceed lip fries worries refused niece gloves soap signature disappoint crawl
+convicted zoo result pages lit flip counsel doubts crimes accusing shaking remembering
+phase hallway halfway bothered useful makeup madam gather concerns cia cameras blackmail
+symptoms rope ordinary imagined concept cigarette supportive memorial explosion yay woo
+trauma ouch furious cheat avoiding whew thick oooh boarding approve urgent shhh
+misunderstanding minister drawer sin phony joining jam interfere governor chapter catching
+bargain tragic schools respond punish penthouse hop thou remains rach ohhh insult bugs
+beside begged absolute strictly stefano socks senses ups sneaking yah serving reward
+polite checks tale physically instructions fooled blows tabby internal bitter adorable
+tested suggestion string jewelry debate com alike pitch fax distracted shelter lessons
+foreign average twin damnit constable circus audition tune shoulders mud mask helpless
+feeding explains dated robbery objection behave valuable shadows courtroom confusing tub
+talented struck smarter mistaken italy customer bizarre scaring punk motherfucker holds
+focused alert activity vecchio reverend highway foolish compliment bastards attend scheme
+aid worker wheelchair protective poetry gentle script reverse picnic knee intended
+construction cage wednesday voices toes stink scares pour effects cheated tower slide
+ruining recent jewish filling exit cottage corporate upside supplies proves parked
+instance grounds diary complaining basis wounded politics confessed pipe merely massage
+data chop budget brief spill prayer costs betray begins arrangement waiter scam rats fraud
+flu brush adopted tables sympathy pill pee web seventeen landed expression entrance
+employee drawing cap bracelet principal pays fairly facility dru deeper arrive unique
+tracking spite shed recommend oughta nanny naive menu grades diet corn authorities
+separated roses patch dime devastated description tap subtle include citizen bullets beans
+ric pile las executive confirm toe strings parade harbor bow borrowed toys straighten
+steak status remote premonition poem planted honored youth specifically meetings exam
+convenient traveling matches laying insisted apply units technology dish aitoro sis kindly
+grandson donor temper teenager strategy proven iron denial couples backwards tent swell
+noon happiest episode drives thinkin spirits potion fence affairs acts whatsoever
+rehearsal proved overheard nuclear lemme hostage faced constant bench tryin taxi shove
+sets moron limits impress entitled needle limit lad intelligent instant forms disagree
+stinks rianna recover losers groom gesture developed constantly blocks bartender tunnel
+suspects sealed removed legally illness hears dresses aye vehicle thy teachers sheet
+receive psychic denied knocking judging bible behalf accidentally waking ton superior seek
+rumor manners homeless hollow desperately critical theme tapes referring personnel item
+genoa gear majesty fans exposed cried tons spells producer launch instinct belief quote
+motorcycle convincing appeal advance greater fashioned aids accomplished grip bump
+upsetting soldiers scheduled production needing invisible forgiveness feds complex compare
+bothers tooth territory sacred mon inviting inner earn compromise cocktail tramp
+temperature signing landing jabot intimate dignity dealt souls informed gods entertainment
+dressing cigarettes blessing billion alistair upper manner lightning leak fond corky
+alternative seduce players operate modern liquor fingerprints enchantment butters stuffed
+stavros rome filed emotionally division conditions uhm transplant tips passes oxygen
+nicely lunatic hid drill designs complain announcement visitors unfortunate slap prayers
+plug organization opens oath mutual graduate confirmed broad yacht spa remembers fried
+extraordinary bait appearance abuse warton sworn stare safely reunion plot burst aha
+experiment dive commission cells aboard returning independent expose environment buddies
+trusting smaller mountains booze sweep sore scudder properly parole manhattan effective
+ditch decides canceled bra speaks spanish reaching glow foundation wears thirsty skull
+ringing dorm dining bend unexpected systems sob pancakes harsh flattered existence ahhh
+troubles proposed fights favourite eats driven computers rage causes border undercover
+spoiled sloane shine rug identify destroying deputy deliberately conspiracy clothing
+thoughtful similar sandwiches plates nails miracles investment fridge drank contrary
+beloved allergic washed stalking solved sack misses forgiven cuz bent approval practical
+organized maciver involve industry fuel dragging cooked possession pointing foul editor
+dull beneath ages horror heels grass faking deaf stunt portrait painted jealousy hopeless
+fears cuts conclusion volunteer scenario satellite necklace crashed chapel accuse
+restraining humans homicide helicopter formal firing shortly safer devoted auction
+videotape tore stores reservations pops appetite wounds vanquish symbol prevent patrol
+ironic flow fathers excitement anyhow tearing sends rape laughed function core charmed sub
+dealer cooperate bachelor accomplish wakes struggle spotted sorts reservation ashes yards
+votes tastes supposedly loft intentions integrity wished towels suspected slightly
+qualified log investigating inappropriate immediate companies backed pan owned lipstick
+lawn compassion cafeteria belonged affected scarf precisely obsession management loses
+lighten infection granddaughter explode chemistry balcony storage spying publicity exists
+employees depend cue cracked conscious aww ally ace accounts absurd vicious tools strongly
+rap invented forbid directions defendant bare announce screwing salesman robbed leap
+lakeview insanity injury genetic document reveal religious possibilities kidnap gown
+entering chairs wishing statue setup serial punished dramatic dismissed criminals seventh
+regrets raped quarters produce lamp dentist anyways anonymous added semester risks
+regarding owes magazines machines lungs explaining delicate tricked oldest liv eager
+doomed cafe bureau adoption traditional surrender stab sickness scum loop independence
+generation floating envelope entered combination chamber worn vault sorel pretended
+potatoes plea photograph payback misunderstood kiddo healing cascade capeside application
+stabbed remarkable cabinet brat wrestling sixth scale privilege passionate nerves lawsuit
+kidney disturbed crossing cozy associate tire shirts required posted oven ordering mill
+journal gallery delay clubs risky nest monsters honorable grounded favour culture closest
+breakdown attempted placed conflict bald actress abandon steam scar pole duh collar
+worthless standards resources photographs introduced injured graduation enormous
+disturbing disturb distract deals conclusions vodka situations require mid measure dishes
+crawling congress briefcase wiped whistle sits roast rented pigs greek flirting existed
+deposit damaged bottles types topic riot overreacting minimum logical impact hostile
+embarrass casual beacon amusing altar values recognized maintain goods covers claus
+battery survival skirt shave prisoners porch med ghosts favors drops dizzy chili begun
+beaten advise transferred strikes rehab raw photographer peaceful leery heavens
+fortunately fooling expectations draft citizens weakness ski ships ranch practicing
+musical movement individual homes executed examine documents cranes column bribe task
+species sail rum resort prescription operating hush fragile forensics expense drugged
+differences cows conduct comic bells avenue attacking assigned visitor suitcase sources
+sorta scan payment motor mini manticore inspired insecure imagining hardest clerk yea
+wrist tube starters silk pump pale nicer haul flies demands boot arts african limited
+elders connections quietly pulls idiots factor erase denying attacks ankle amnesia
+accepting ooo heartbeat gal devane confront backing phrase operations minus meets
+legitimate hurricane fixing communication boats auto arrogant supper studies slightest
+sins sayin recipe pier paternity humiliating genuine catholic snack rational pointed
+minded guessed display dip advanced weddings unh tumor teams reported humiliated
+destruction copies closely bid aspirin academy wig throughout spray occur logic eyed equal
+drowning contacts shakespeare ritual perfume hiring hating generally error elected docks
+creatures visions thanking thankful sock replaced nineteen fork comedy analysis yale
+throws teenagers studied stressed slice rolls requires plead ladder kicks detectives
+assured widow tissue tellin shallow responsibilities repay rejected permanently
+girlfriends deadly comforting ceiling bonus verdict maintenance jar insensitive factory
+aim triple spilled respected recovered messy interrupted halliwell bleed benefits wardrobe
+takin significant objective murders doo chart backs workers waves underestimate ties
+registered multiple justify harmless frustrated fold enzo convention communicate bugging
+attraction arson whack salary rumors residence obligation medium liking development
+develop dearest congratulate vengeance switzerland severe rack puzzle puerto guidance
+fires courtesy caller blamed tops repair quiz prep involves headquarters curiosity codes
+circles barbecue troops sunnydale spinning scores pursue psychotic cough claimed
+accusations shares resent laughs gathered freshman envy drown bartlet asses sofa scientist
+poster islands highness dock apologies welfare theirs stat stall spots somewhat realizes
+psych fools finishing album wee understandable unable treats theatre succeed stir relaxed
+makin inches gratitude faithful bin accent zip witter wandering regardless que locate
+inevitable gretel deed crushed controlling taxes smelled settlement robe poet opposed
+marked gossip gambling determine cuba cosmetics cent accidents surprising stiff sincere
+shield rushed resume reporting refrigerator reference preparing nightmares mijo ignoring
+hunch fog fireworks drowned crown cooperation brass accurate whispering sophisticated
+religion luggage investigate hike explore emotion creek crashing contacted complications
+ceo acid shining rolled righteous reconsider inspiration goody geek frightening festival
+ethics creeps courthouse camping assistance affection vow smythe protest lodge haircut
+forcing essay chairman baked apologized vibe respects receipt mami includes hats exclusive
+destructive define defeat adore adopt voted tracked signals shorts reminding relative
+ninth floors dough creations continues cancelled cabot barrel snuck slight reporters rear
+pressing novel newspapers magnificent madame lazy glorious fiancee candidate brick bits
+australia activities visitation scholarship sane previous kindness shoulda rescued
+mattress lounge lifted label importantly glove enterprises disappointment condo cemetery
+beings admitting yelled waving screech satisfaction requested reads plants nun nailed
+described dedicated certificate centuries annual worm tick resting primary polish
+marvelous fuss funds defensive cortlandt compete chased provided pockets luckily lilith
+filing depression conversations consideration consciousness worlds innocence indicate
+forehead bam appeared aggressive trailer slam retirement quitting pry narrow levels inform
+encourage dug delighted daylight danced currently confidential aunts washing vic tossed
+spectra permit marrow lined implying hatred grill efforts corpse clues sober relatives
+promotion offended morgue larger infected humanity eww electricity electrical distraction
+cart broadcast wired violation suspended promising harassment glue gathering cursed
+controlled calendar brutal assets warlocks wagon unpleasant proving priorities observation
+lease grows flame domestic disappearance depressing thrill sitter ribs offers naw flush
+exception earrings deadline corporal collapsed update snapped smack orleans offices melt
+figuring delusional coulda burnt actors trips tender sperm specialist scientific realise
+pork popped planes kev interrogation institution included esteem communications choosing
+choir undo pres prayed plague manipulate lifestyle insulting honour detention delightful
+coffeehouse chess betrayal apologizing adjust wrecked wont whipped rides reminder
+psychological principle monsieur injuries fame faint confusion bon bake nearest korea
+industries execution distress definition creating correctly complaint blocked trophy
+tortured structure rot risking pointless household heir handing eighth dumping cups alibi
+absence vital tokyo thus struggling shiny risked refer mummy mint involvement hose hobby
+fortunate fleischman fitting curtain counseling addition wit transport technical rode
+puppet opportunities modeling memo irresponsible humiliation hiya freakin fez felony choke
+blackmailing appreciated tabloid suspicion recovering rally psychology pledge panicked
+nursery louder jeans investigator identified homecoming height graduated frustrating
+fabric distant buys busting buff wax sleeve products philosophy irony hospitals dope
+declare autopsy workin torch substitute scandal prick limb leaf hysterical growth
+goddamnit fetch dimension crowded clip climbing bonding approved yeh woah ultimately
+trusts returns negotiate millennium majority lethal length iced deeds bore babysitter
+questioned outrageous medal kiriakis insulted grudge established driveway deserted
+definite capture beep wires suggestions searched owed originally nickname lighting lend
+drunken demanding costanza conviction characters bumped weigh touches tempted shout
+resolve relate poisoned pip occasionally meals maker invitations haunted fur footage
+depending bogus autograph affects tolerate stepping spontaneous sleeps probation
+presentation performed manny identical fist cycle associates streak spectacular sector
+lasted increase hostages heroin havin habits encouraging cult consult burgers boyfriends
+bailed baggage association wealthy watches versus troubled torturing teasing sweetest
+stations sip rag qualities postpone pad overwhelmed malkovich impulse hut follows classy
+charging amazed scenes rising revealed representing policeman offensive mug hypocrite
+humiliate hideous finals experiences courts costumes captured bluffing betting bein
+bedtime alcoholic vegetable tray suspicions spreading splendid shouting roots pressed nooo
+jew intent grieving gladly fling eliminate disorder cereal arrives aaah yum technique
+statements sonofabitch servant roads republican paralyzed orb lotta locks guaranteed
+european dummy discipline despise dental corporation carries briefing bluff batteries
+atmosphere whatta tux sounding servants rifle presume handwriting goals gin fainted
+elements dried cape allright allowing acknowledge whacked toxic skating reliable quicker
+penalty panel overwhelming nearby lining importance harassing fatal endless elsewhere
+dolls convict bold ballet whatcha unlikely spiritual shutting separation recording
+positively overcome goddam failing essence dose diagnosis cured claiming bully airline
+ahold yearbook various tempting shelf rig pursuit prosecution pouring possessed
+partnership countries wonders tsk thorough spine rath psychiatric meaningless latte jammed
+ignored fiance exposure exhibit evidently duties contempt compromised capacity cans
+weekends urge theft suing shipment scissors responding refuses proposition noises matching
+located ink hormones hiv hail grandchildren godfather gently establish contracts compound
+worldwide smashed sexually sentimental senor scored nicest marketing manipulated jaw
+intern handcuffs framed errands entertaining discovery crib carriage barge awards
+attending ambassador videos tab spends slipping seated rubbing rely reject recommendation
+reckon ratings headaches float embrace corners whining sweating sole skipped restore
+receiving population pep mountie motives listens korean heroes cristobel controls
+cheerleader balsom unnecessary stunning shipping scent quartermaines praise pose montega
+luxury loosen info hum haunt gracious git forgiving fleet errand emperor cakes blames
+abortion worship theories strict sketch shifts plotting physician perimeter passage pals
+mere mattered lonigan longest jews interference eyewitness enthusiasm encounter diapers
+artists strongest shaken serves punched projects portal outer nazi colleagues catches
+bearing backyard academic winds terrorists sabotage pea organs needy mentor measures
+listed lex cuff civilization caribbean articles writes woof valid rarely rabbi prank
+performing obnoxious mates improve hereby gabby faked cellar whitelighter void substance
+strangle sour skill senate purchase native muffins interfering hoh demonic colored
+clearing civilian buildings boutique barrington trading terrace smoked seed righty
+relations quack published preliminary petey pact outstanding opinions knot ketchup items
+examined disappearing cordy coin circuit assist administration walt uptight ticking
+terrifying tease syd swamp secretly rejection reflection realizing rays pennsylvania
+partly mentally marone jurisdiction doubted deception crucial congressman cheesy arrival
+visited supporting stalling scouts scoop ribbon reserve raid notion income immune expects
+edition destined constitution classroom bets appreciation appointed accomplice wander
+shoved sewer scroll retire paintings lasts fugitive freezer discount cranky crank
+clearance bodyguard anxiety accountant whoops volunteered terrorist tales talents stinking
+resolved remotely protocol garlic decency cord beds areas altogether uniforms tremendous
+restaurants rank profession popping philadelphia outa observe lung largest hangs feelin
+experts enforcement encouraged economy dudes donation disguise curb continued competitive
+businessman bites antique advertising ads toothbrush retreat represents realistic profits
+predict lid landlord hourglass hesitate focusing equally consolation babbling aged tipped
+stranded smartest rhythm replacement repeating puke psst paycheck overreacted macho
+leadership juvenile images grocery freshen disposal cuffs consent caffeine arguments
+agrees vanished unfinished tobacco tin syndrome ripping pinch missiles isolated flattering
+expenses dinners cos colleague ciao buh belthazor attorneys woulda whereabouts wars waitin
+visits truce tripped tee tasted stu steer ruling poisoning nursing manipulative immature
+husbands heel granddad delivering deaths condoms automatically anchor trashed tournament
+throne raining prices pasta needles leaning leaders judges ideal detector coolest casting
+batch approximately appointments almighty achieve vegetables sum spark ruled revolution
+principles perfection pains momma mole interviews initiative hairs getaway employment den
+cracking counted compliments behold verge tougher timer tapped taped stakes specialty
+snooping shoots semi rendezvous pentagon passenger leverage jeopardize janitor
+grandparents forbidden examination communist clueless cities bidding arriving adding
+ungrateful unacceptable tutor soviet shaped serum scuse savings pub pajamas mouths modest
+methods lure irrational depth cries classified bombs beautifully arresting approaching
+vessel variety traitor sympathetic smug smash rental prostitute premonitions mild jumps
+inventory ing improved developing darlin committing banging asap amendment worms violated
+vent traumatic traced tow swiss sweaty shaft recommended overboard literature insight
+healed grasp fluid experiencing crappy crab connecticut chunk awww applied witnessed
+traveled stain shack reacted pronounce presented poured occupied moms marriages jabez
+invested handful gob gag flipped fireplace expertise embarrassment disappears concussion
+bruises brakes twisting tide swept summon splitting settling scientists reschedule regard
+purposes ohio notch improvement hooray grabbing extend exquisite disrespect complaints
+armor voting thornhart sustained straw slapped shipped shattered ruthless refill recorded
+payroll numb mourning marijuana manly involving hunk entertain earthquake drift dreadful
+doorstep confirmation chops appreciates announced vague tires stressful stem stashed stash
+sensed preoccupied predictable noticing madly halls gunshot embassy dozens confuse
+cleaners charade chalk cappuccino breed bouquet amulet addiction warming unlock transition
+satisfy sacrificed relaxing lone input hampshire elaborate concerning completed channels
+category cal blocking blend blankets addicted yuck voters professionals positions mode
+initial hunger hamburger greeting greet gravy gram dreamt dice declared collecting caution
+backpack agreeing writers whale tribe taller supervisor sacrifices radiation poo phew
+outcome ounce missile meter likewise irrelevant gran felon feature favorites farther fade
+experiments erased easiest disk convenience conceived compassionate challenged cane
+backstage agony adores veins tweek thieves surgical strangely stetson recital proposing
+productive meaningful marching immunity hassle goddamned frighten directors dearly
+comments closure cease ambition wisconsin unstable sweetness salvage richer refusing
+raging pumping pressuring petition mortals lowlife jus intimidated intentionally inspire
+forgave devotion despicable deciding dash comfy breach bark alternate aaaah switching
+swallowed stove slot screamed scars russians relevant poof pipes persons pawn losses legit
+invest generations farewell experimental difficulty curtains civilized championship caviar
+boost token tends temporarily superstition supernatural sunk sadness reduced recorder
+psyched presidential owners motivated microwave lands hallelujah gap fraternity engines
+dryer cocoa chewing additional acceptable unbelievably survivor smiled smelling sized
+simpler sentenced respectable remarks registration premises passengers organ occasional
+khasinau indication gutter grabs goo fulfill flashlight ellenor courses blooded blessings
+beware bands advised uhhh turf swings slips shocking resistance privately mirrors lyrics
+locking instrument historical heartless fras decades comparison childish cardiac admission
+utterly tuscany ticked suspension stunned statesville sadly resolution reserved purely
+opponent noted lowest kiddin jerks hitch flirt fare extension establishment equals dismiss
+delayed decade christening casket breakup biting antibiotics accusation abducted
+witchcraft traded thread spelling runnin remaining punching protein printed paramedics
+newest murdering masks lawndale intact ins initials heights grampa democracy deceased
+choking charms careless bushes buns bummed accounting travels shred saves saddle rethink
+regards references precinct persuade patterns meds manipulating llanfair leash housing
+hearted guarantees flown feast extent educated disgrace determination deposition coverage
+corridor burial bookstore boil abilities vitals veil trespassing teaches sidewalk sensible
+punishing overtime optimistic occasions obsessing oak notify mornin jeopardy jaffa
+injection hilarious distinct directed desires curve confide challenging cautious alter
+yada wilderness vindictive vial tomb teeny subjects stroll sittin scrub rebuild posters
+parallel ordeal orbit nuns intimacy inheritance fails exploded donate distracting despair
+democratic defended crackers commercials ammunition wildwind virtue thoroughly tails spicy
+sketches sights sheer shaving seize scarecrow refreshing prosecute possess platter napkin
+misplaced merchandise membership loony jinx heroic frankenstein fag efficient corps clan
+boundaries attract ambitious virtually syrup solitary resignation resemblance reacting
+pursuing premature pod lavery journalist honors genes flashes erm contribution cheque
+charts cargo awright acquainted wrapping untie salute ruins resign realised priceless
+partying myth moonlight lightly lifting kasnoff insisting glowing generator flowing
+explosives employer cutie confronted clause buts breakthrough blouse ballistic antidote
+analyze allowance adjourned vet unto understatement tucked touchy toll subconscious
+sequence screws sarge roommates reaches rambaldi programs offend nerd knives kin
+irresistible inherited incapable hostility goddammit fuse frat equation curfew centered
+blackmailed allows alleged walkin transmission text starve sleigh sarcastic recess rebound
+procedures pinned parlor outfits livin issued institute industrial heartache haired
+fundraiser doorman documentary discreet dilucca detect cracks cracker considerate climbed
+catering author apophis zoey vacuum urine tunnels tanks strung stitches sordid sark
+referred protector portion phoned pets paths mat lengths kindergarten hostess flaw flavor
+discharge deveraux consumed confidentiality automatic amongst viktor tactics straightened
+specials spaghetti soil prettier powerless por poems playin playground paranoia nsa mainly
+instantly havoc exaggerating evaluation eavesdropping doughnuts diversion deepest cutest
+companion comb bela behaving avoided anyplace agh accessory zap whereas translate stuffing
+speeding slime polls personalities payments musician marital lurking lottery journalism
+interior imaginary hog guinea greetings fairwinds ethical equipped environmental elegant
+elbow customs cuban credibility credentials consistent collapse cloth claws chopped
+challenges bridal boards bedside babysitting authorized assumption ant youngest witty vast
+unforgivable underworld tempt tabs succeeded sophomore selfless secrecy runway restless
+programming professionally okey movin metaphor messes meltdown lecter incoming hence
+gasoline gained funding episodes diefenbaker contain comedian collected cam buckle
+assembly ancestors admired adjustment acceptance weekly warmth throats seduced reform
+queer poll parenting noses luckiest graveyard gifted footsteps dimeras cynical
+assassination wedded voyage volunteers verbal unpredictable tuned stoop slides sinking rio
+rigged regulations region promoted plumbing lingerie layer hankey greed everwood essential
+elope dresser departure dat dances coup chauffeur bulletin bugged bouncing website tubes
+temptation supported strangest slammed selection sarcasm rib primitive platform pending
+partial packages orderly obsessive nevertheless nbc murderers motto meteor inconvenience
+glimpse froze fiber execute etc ensure drivers dispute damages crop courageous consulate
+closes bosses bees amends wuss wolfram wacky unemployed traces testifying tendency syringe
+symphony stew startled sorrow sleazy shaky screams rsquo remark poke nutty nobel
+mentioning mend iowa inspiring impulsive housekeeper germans formed foam fingernails
+economic divide conditioning baking whine thug starved sedative reversed publishing
+programmed picket paged nowadays mines invasion homosexual homo hips forgets flipping flea
+flatter dwell dumpster consultant choo banking assignments apartments ants affecting
+advisor vile unreasonable tossing thanked steals souvenir screening scratched rep
+psychopath proportion outs operative obstruction obey neutral lump insists harass gloat
+flights filth extended electronic edgy diseases didn coroner confessing cologne cedar
+bruise betraying bailing attempting appealing adebisi wrath wandered waist vain traps
+transportation stepfather publicly presidents poking obligated marshal instructed heavenly
+halt employed diplomatic dilemma crazed contagious coaster cheering carved bundle
+approached appearances vomit thingy stadium speeches robbing reflect raft qualify pumped
+pillows peep pageant packs neo neglected loneliness liberal intrude indicates helluva
+gardener freely forresters err drooling continuing betcha addressed acquired vase
+supermarket squat spitting spaces slaves rhyme relieve receipts racket purchased preserve
+pictured pause overdue officials nod motivation morgendorffer lacking kidnapper
+introduction insect hunters horns feminine eyeballs dumps disc disappointing difficulties
+crock convertible context claw clamp canned cambias bathtub avanya artery weep warmer
+vendetta tenth suspense summoned spiders sings reiber raving pushy produced poverty
+postponed ohhhh noooo mold mice laughter incompetent hugging groceries frequency fastest
+drip differ communicating beliefs bats bases auntie adios wraps willingly weirdest voila
+timmih thinner swelling swat steroids sensitivity scrape rehearse quarterback organic
+matched ledge justified insults increased heavily hateful handles feared doorway
+decorations colour chatting buyer buckaroo bedrooms batting askin ammo tutoring subpoena
+span scratching requests privileges pager mart kel intriguing idiotic hotels grape
+enlighten dum demonstrate dairy corrupt combined brunch bridesmaid barking architect
+applause alongside ale acquaintance yuh wretched superficial sufficient sued soak smoothly
+sensing restraint quo pow posing pleading pittsburgh peru payoff participate organize
+oprah nemo morals loans loaf lists laboratory jumpy intervention ignorant herbal hangin
+germs generosity flashing convent clumsy chocolates captive behaved apologise vanity
+trials stumbled republicans represented recognition preview poisonous perjury parental
+onboard mugged minding linen learns knots interviewing inmates ingredients humour grind
+greasy goons estimate elementary drastic database coop comparing cocky clearer bruised
+brag bind axe asset apparent worthwhile whoop vanquishing tabloids survivors sprung
+spotlight shops sentencing sentences revealing reduce ram racist provoke pining overly oui
+ops mop louisiana locket jab imply impatient hovering hotter fest endure dots doren dim
+diagnosed debts cultures crawled contained condemned chained brit breaths adds weirdo
+warmed wand utah troubling stripped strapped soaked skipping scrambled rattle profound
+musta mocking mnh misunderstand merit loading linked limousine kacl investors interviewed
+hustle forensic foods enthusiastic duct drawers devastating democrats conquer
+concentration comeback clarify chores cheerleaders cheaper callin blushing barging abused
+yoga wrecking wits waffles virginity vibes uninvited unfaithful underwater tribute
+strangled scheming ropes responded residents rescuing rave priests postcard overseas
+orientation ongoing newly morphine lotion limitations lesser lectures lads kidneys
+judgement jog itch intellectual installed infant indefinitely grenade glamorous
+genetically freud faculty engineering doh discretion delusions declaration crate competent
+commonwealth catalog bakery attempts asylum argh applying ahhhh wedge wager unfit tripping
+treatments torment superhero stirring spinal sorority seminar scenery repairs rabble
+pneumonia perks owl override ooooh moo mija manslaughter mailed lime lettuce intimidate
+instructor guarded grieve grad globe frustration extensive exploring exercises doorbell
+devices dam cultural ctu credits commerce chinatown chemicals baltimore authentic
+arraignment annulled altered allergies wanta verify vegetarian tunes tourist tighter
+telegram suitable stalk specimen spared solving shoo satisfying saddam requesting
+publisher pens overprotective obstacles notified negro nasedo judged identification
+grandchild genuinely founded flushed fluids floss escaping ditched decorated criticism
+cramp corny contribute connecting bunk bombing bitten billions bankrupt yikes wrists
+ultrasound ultimatum thirst spelled sniff scope retrieve releasing reassuring pumps
+properties predicted neurotic negotiating multi monitors millionaire microphone mechanical
+lydecker limp incriminating hatchet gracias gordie fills feeds egypt doubting dedication
+decaf competing cellular biopsy whiz voluntarily visible ventilator unpack unload
+universal tomatoes targets suggests strawberry spooked snitch schillinger sap reassure
+providing prey persuasive mystical mysteries mri mixing matrimony mails lighthouse
+liability kgb jock headline factors explosive explanations dispatch detailed curly cupid
+condolences comrade cassadines bulb bragging awaits assaulted ambush adolescent adjusted
+abort yank whit verse vaguely undermine tying trim swamped stitch stabbing slippers
+sincerely sigh setback secondly rotting rev retail proceedings preparation precaution pox
+pcpd nonetheless melting materials mar liaison hots hooking headlines hag ganz fury
+felicity fangs expelled encouragement earring dreidel draws dory donut dis dictate
+dependent decorating coordinates cocktails bumps blueberry believable backfired backfire
+apron anticipated adjusting activated vous vouch vitamins vista urn uncertain ummm
+tourists tattoos surrounding sponsor slimy singles sibling shhhh restored representative
+renting reign publish planets peculiar parasite paddington noo marries mailbox magically
+lovebirds listeners knocks informant grain exits elf drazen distractions disconnected
+dinosaurs designing dashwood crooked conveniently contents argued wink warped
+underestimated testified tacky substantial steering staged stability shoving seizure reset
+repeatedly radius pushes pitching pairs opener mornings mississippi mash investigations
+invent indulge horribly hallucinating festive eyebrows expand enjoys dictionary dialogue
+desperation dealers darkest daph critic consulting canal boragora belts bagel
+authorization auditions associated ape agitated adventures withdraw wishful wimp vehicles
+vanish unbearable tonic tackle suffice suction slaying singapore safest rocking relive
+rates puttin prettiest oval noisy newlyweds nauseous moi misguided mildly midst maps
+liable judgmental introducing individuals hunted hen givin frequent fisherman fascinated
+elephants dislike diploma deluded decorate crummy contractions carve careers bottled
+bonded bahamas unavailable twenties trustworthy translation traditions surviving surgeons
+stupidity skies secured salvation remorse princeton preferably pies photography
+operational nuh northwest nausea napkins mule mourn melted mechanism mashed inherit
+holdings hel greatness golly excused edges dumbo drifting delirious damaging cubicle
+compelled comm colleges chooses checkup certified candidates boredom bandages bah
+automobile athletic alarms absorbed absent windshield whaddya vitamin transparent
+surprisingly sunglasses starring slit sided schemes roar relatively reade quarry
+prosecutor prognosis probe potentially pitiful persistent perception percentage peas oww
+nosy neighbourhood nagging morons molecular meters masterpiece martinis limbo liars
+irritating inclined hump hoynes haw gauge functions fiasco educational eatin donated
+destination dense cubans continent concentrating commanding colorful clam cider brochure
+behaviour barto bargaining awe artistic welcoming weighing villain vein vanquished
+striking stains sooo smear sire secondary roughly rituals resentment psychologist
+preferred pint pension passive overhear origin orchestra negotiations mounted morality
+landingham labs kisser icy hoot holling handshake grilled functioning formality elevators
+depths confirms civilians bypass briefly boathouse binding acres accidental westbridge
+wacko ulterior transferring tis thugs tangled stirred sought snag smallest sling sleaze
+seeds rumour ripe remarried reluctant regularly puddle promote precise popularity pins
+perceptive miraculous memorable maternal longing lockup locals librarian inspection
+impressions immoral hypothetically guarding gourmet gabe fighters fees features faxed
+extortion expressed essentially downright digest der crosses cranberry chorus casualties
+bygones buzzing burying bikes attended allah weary viewing viewers transmitter taping
+takeout sweeping stepmother stating stale seating seaborn resigned rating pros pepperoni
+ownership occurs newborn merger mandatory ludicrous injected heating geeks forged faults
+expressing drue dire dief desi deceiving centre celebrities caterer calmed businesses
+budge applications ankles vending typing tribbiani squared speculation snowing shades
+sexist scattered sanctuary rewrite regretted regain raises processing picky orphan mural
+misjudged miscarriage memorize licensed lens leaking launched languages jitters invade
+interruption implied illegally handicapped glitch gittes finer fewer engineered distraught
+dispose dishonest digs dads cruelty conducting clinical circling champions canceling
+butterflies belongings barbrady amusement allegations alias aging zombies unborn tri
+swearing stables squeezed slavery sew sensational revolutionary resisting removing
+radioactive races questionable privileged portofino par owning overlook overhead orson
+oddly nazis musicians interrogate instruments imperative impeccable icu hurtful hors heap
+graduating graders glance endangered disgust devious destruct demonstration creates
+crazier countdown chump cheeseburger burglar brotherhood berries ballroom assumptions ark
+annoyed allies allergy advantages admirer admirable addresses activate accompany wed valve
+underpants twit triggered tack strokes stool sham seasons sculpture scrap sailed retarded
+resourceful remarkably refresh ranks pressured precautions pointy obligations nightclub
+mustache minority maui lace improving iii hunh hubby flare fierce farmers dont dokey
+divided demise demanded dangerously crushing considerable complained clinging choked chem
+cheerleading checkbook cashmere calmly blush believer aspect amazingly alas acute yak
+whores tuition tolerance toilets tactical tacos stairwell spur spirited slower sewing
+separately rubbed restricted punches protects partially ole nuisance niagara motherfuckers
+mingle kynaston knack kinkle impose hosting gullible grid godmother funniest friggin
+folding financially filming fashions eater dysfunctional drool distinguished defence
+defeated cruising crude criticize corruption contractor conceive clone circulation cedars
+caliber brighter blinded birthdays bio banquet artificial anticipate annoy achievement
+whim whichever volatile veto vested supports successfully shroud severely rests
+representation quarantine premiere pleases painless pads orphans orphanage offence obliged
+nip niggers negotiation narcotics nag mistletoe meddling manifest lookit loo lilah
+investigated intrigued injustice homicidal gigantic exposing elves disturbance disastrous
+depended demented correction cooped cheerful buyers brownies beverage basics atm arvin
+arcade weighs upsets unethical tidy swollen sweaters swap stupidest sensation scalpel rail
+prototype props prescribed pompous poetic ploy paws operates objections mushrooms mulwray
+monitoring manipulation lured lays lasting kung keg jell internship insignificant inmate
+incentive gandhi fulfilled flooded expedition evolution discharged disagreement dine crypt
+cornered copied confrontation cds catalogue brightest beethoven banned attendant athlete
+amaze airlines yogurt wyndemere wool vocabulary vcr tulsa tags tactic stuffy slug
+sexuality seniors segment revelation respirator pulp prop producing processed pretends
+polygraph perp pennies ordinarily opposition olives necks morally martyr martial leftovers
+joints irs invaded imported hopping homey hints helicopters heed heated heartbroken gulf
+greatly forge florist firsthand fiend expanding defenses crippled corrected conniving
+conditioner clears chemo bubbly bladder beeper baptism apb angles ache womb wiring wench
+weaknesses volunteering violating unlocked unemployment tummy tibet threshold surrogate
+submarine subid stray stated startle specifics snob slowing sled scoot robbers rightful
+richest quid qfxmjrie puffs probable pitched pierced pencils paralysis nuke managing
+makeover luncheon lords linksynergy jacuzzi ish interstate hitched historic hangover gasp
+fracture flock firemen drawings disgusted darned coal clams chez cables broadcasting brew
+borrowing banged achieved wildest weirder unauthorized stunts sleeves sixties shush shalt
+senora rises retro quits pupils politicians pegged painfully paging outlet omelet observed
+memorized lawfully jackets interpretation intercept ingredient grownup glued gaining
+fulfilling flee enchanted dvd delusion daring conservative conducted compelling charitable
+carton bronx bridesmaids bribed boiling bathrooms bandage awareness awaiting assign
+arrogance antiques ainsley turkeys travelling trashing tic takeover sync supervision
+stockings stalked stabilized spacecraft slob skates sirs sedated robes reviews respecting
+psyche prominent prizes presumptuous prejudice platoon permitted paragraph mush movements
+mist missions mints mating mantan lorne loads listener legendary itinerary hugs hepatitis
+heave guesses gender flags fading exams examining egyptian dumbest dishwasher describing
+deceive cunning cripple cove convictions congressional confided compulsive compromising
+burglary bun bumpy brainwashed benes arnie alvy affirmative adrenaline adamant watchin
+waitresses uncommon treaty transgenic toughest surround stormed spree spilling spectacle
+soaking significance shreds sewers severed scarce scamming scalp rewind rehearsing
+pretentious potions possessions planner placing periods overrated obstacle notices nerds
+meems medieval mcmurphy maturity maternity masses maneuver lyin loathe irv investigators
+hep grin gospel gals formation fertility facilities exterior epidemic eloping ecstatic
+ecstasy duly divorcing distribution dignan debut costing coaching clubhouse clot clocks
+classical candid bursting breather braces bending australian attendance arsonist applies
+adored accepts absorb vacant uuh uphold unarmed turd topolsky thrilling thigh terminate
+tempo sustain spaceship snore sneeze smuggling shrine sera salty salon ramp quaint
+prostitution prof policies patronize patio nasa morbid mamma locations licence kettle
+joyous invincible interpret insecurities insects inquiry infamous impulses illusions holed
+fragments exploit economics drivin des defy defenseless dedicate cradle cpr coupon
+countless conjure confined celebrated cardboard booking blur bleach ban backseat
+alternatives afterward accomplishment wordsworth wisely wildlife valet vaccine urges
+unnatural unlucky truths traumatized tit tennessee tasting swears strawberries steaks
+stats skank seducing secretive screwdriver schedules rooting rightfully rattled qualifies
+puppets provides prospects pronto prevented powered posse poorly polling pedestal palms
+muddy morty miniature microscope merci margin lecturing inject incriminate hygiene
+grapefruit gazebo funnier freight flooding equivalent eliminated dios cuter continental
+container cons compensation clap cbs cavity caves capricorn canvas calculations bossy
+booby bacteria aides zende winthrop wider warrants valentines undressed underage
+truthfully tampered suffers stored statute speechless sparkling sod socially sidelines
+shrek sank railing puberty practices pesky parachute outrage outdoors operated openly
+nominated motions moods lunches litter kidnappers itching intuition index imitation icky
+humility hassling gallons firmly excessive evolved employ eligible elections elderly
+drugstore dosage disrupt directing dipping deranged debating cuckoo cremated craziness
+cooperating compatible circumstantial chimney blinking biscuits belgium arise analyzed
+admiring acquire accounted weeping volumes views triad trashy transaction tilt soothing
+slumber slayers skirts siren shindig sentiment rosco riddance rewarded quaid purity
+proceeding pretzels practiced politician polar panicking overall occupation naming minimal
+mckechnie massacre lovin leaked layers isolation intruding impersonating ignorance hoop
+hamburgers fruits footprints fluke fleas festivities fences feisty evacuate emergencies
+diabetes detained democrat deceived creeping craziest corpses conned coincidences
+charleston bums brussels bounced bodyguards blasted bitterness baloney ashtray apocalypse
+advances zillion watergate wallpaper viable tenants telesave sympathize sweeter swam sup
+startin stages sodas snowed sleepover signor seein reviewing reunited retainer restroom
+rested replacing repercussions reliving reef reconciliation reconcile recognise prevail
+preaching planting overreact oof omen numerous noose moustache manicure maids mah landlady
+hypothetical hopped homesick hives hesitation herbs hectic heartbreak haunting gangs frown
+fingerprint extract expired exhausting exchanged exceptional everytime encountered
+disregard daytime cooperative constitutional cling chevron chaperone buenos blinding bitty
+beads battling badgering anticipation advocate waterfront upstanding unprofessional unity
+unhealthy undead turmoil truthful toothpaste tippin thoughtless tagataya stretching
+strategic spun shortage shooters shady senseless sailors rewarding refuge rapid rah pun
+propane pronounced preposterous pottery portable pigeons pastry overhearing ogre obscene
+novels negotiable mtv monthly loner leisure leagues jogging jaws itchy insinuating insides
+induced immigration hospitality hormone hearst frequently forthcoming fists fifties
+etiquette endings elevated editing dunk distinction disabled dibs destroys despises
+desired designers deprived dancers dah cuddy crust conductor communists cloak circumstance
+chewed casserole bora bidder bearer assessment artoo applaud appalling amounts admissions
+withdrawal weights vowed virgins vigilante vatican undone trench touchdown throttle thaw
+tha testosterone tailor symptom swoop suited suitcases stomp sticker stakeout spoiling
+snatched smoochy smitten shameless restraints researching renew relay regional refund
+reclaim rapids raoul rags puzzles purposely punks prosecuted plaid pineapple picturing
+pickin pbs parasites offspring nyah mysteriously multiply mineral masculine mascara laps
+jukebox interruptions hoax gunfire gays furnace exceptions engraved elbows duplicate
+drapes designated deliberate deli decoy cub cryptic crowds critics coupla convert
+conventional condemn complicate combine colossal clerks clarity byes brushed banished
+arrests argon alarmed worships versa uncanny troop treasury transformation terminated
+telescope technicality sundae stumble stripping shuts separating schmuck saliva robber
+retain remained relentless reconnect recipes rearrange rainy psychiatrists producers
+policemen plunge plugged patched overload ofc obtained obsolete numbered nay moth module
+mkay mindless menus lullaby lotte leavin layout knob killin karinsky irregular invalid
+hides grownups griff flaws flashy flaming fettes evicted epic encoded dread dil degrassi
+dealings dangers cushion console concluded bowel beginnings barged apes announcing admits
+abroad abide abandoning workshop wonderfully woak warfare wad violate turkish ter targeted
+suicidal stayin sorted slamming sketchy shoplifting shapes selected retiring raiser
+quizmaster pursued pupkin profitable prefers politically phenomenon olympics needless mutt
+motherhood momentarily migraine lilo lifts leukemia leftover keepin idol hinks hellhole
+gowns goodies gallon futures friction finale farms extraction entertained electronics
+eighties dmv darker cum conspiring consequence cheery caps calf cadet builds benign
+aspects artillery apiece aggression adjustments abusive abduction wiping whipping welles
+unspeakable unlimited unidentified trivial transcripts threatens textbook tenant supervise
+superstitious stricken stretched stimulating steep statistics spielberg sodium slices
+shelves scratches saudi sabotaged retrieval repressed relation rejecting quickie promoting
+ponies peeking paw paolo outraged observer moping moaning mausoleum males licked kovich
+klutz iraq interrogating interfered intensive insulin infested incompetence hyper
+horrified handedly hacked guiding glamour geoff gekko fraid fractured formerly flour
+firearms fend executives examiner evaluate eloped disoriented delivers dashing crystals
+crossroads crashdown conclude coffees cockroach climate chipped camps brushing boulevard
+bombed bolts begs baths baptized astronaut assurance anemia allegiance aiming abuela
+abiding workplace withholding weave wearin weaker warnings usa tours thesis terrorism
+suffocating straws straightforward stench steamed starboard sideways shrinks shortcut
+scram roasted roaming riviera respectfully repulsive recognizes receiver psychiatry
+provoked penitentiary peed pas painkillers oink norm ninotchka muslim mitzvah milligrams
+mil midge marshmallows markets looky lapse kubelik knit jeb investments intellect
+improvise implant hometown hanged handicap halo giddy geniuses fruitcake footing flop
+findings fightin fib editorial drinkin doork discovering detour danish cuddle crashes
+coordinate combo colonnade collector cheats cetera canadians bip bailiff auditioning assed
+amused alienate algebra alexi aiding aching woe wah unwanted typically tug topless tongues
+tiniest symbols superiors soy soften sheldrake sensors seller seas ruler rival rips
+renowned recruiting reasoning rawley raisins racial presses preservation portfolio
+oversight organizing obtain observing nessa narrowed minions midwest meth merciful manages
+magistrate lawsuits labour invention intimidating infirmary indicated inconvenient
+imposter hugged honoring holdin hades godforsaken fumes forgery foremost foolproof folder
+folded flattery fingertips financing fifteenth exterminator explodes eccentric drained
+dodging documented disguised developments currency crafts constructive concealed
+compartment chute chinpokomon captains capitol calculated buses bodily astronauts alimony
+accustomed accessories abdominal zen wrinkle wallow viv vicinity venue valued valium
+upgrade upcoming untrue uncover twig twelfth trembling treasures torched toenails timed
+termites telly taunting taransky tar talker succubus statues smarts sliding sizes sighting
+semen seizures scarred savvy sauna saddest sacrificing rubbish riled rican revive recruit
+ratted rationally provenance professors prestigious pms phonse perky pedal overdose
+organism nasal nanites mushy movers moot missus midterm merits melodramatic manure
+magnetic knockout knitting jig invading interpol incapacitated idle hotline highlight
+hauling gunpoint greenwich grail ganza framing formally fleeing flap flannel fin fibers
+faded existing email eavesdrop dwelling dwarf donations detected desserts dar corporations
+constellation collision chic calories businessmen breathtaking bleak blacked batter
+balanced ante aggravated agencies abu yanked wuh withdrawn wigand whoah wham vocal unwind
+undoubtedly unattractive twitch trimester torrance timetable taxpayers strained stationed
+stared slapping sincerity signatures siding siblings shenanigans shacking seer satellites
+sappy samaritan rune regained rebellion proceeds privy poorer politely paste oysters
+overruled olaf nightcap networks necessity mosquito millimeter merrier massachusetts
+manuscript manufacture manhood lunar lug lucked loaned kilos ignition hurl hauled harmed
+goodwill freshmen forming fenmore fasten farce failures exploding erratic elm drunks
+ditching crops cramped contacting coalition closets clientele chimp cavalry casa cabs bled
+bargained arranging archives anesthesia amuse altering afternoons accountable abetting
+wrinkles wolek waved unite uneasy unaware ufo toot toddy tens tattooed sway stained
+spauldings solely sliced sirens schibetta scatter rumours rinse remo remedy redemption
+progressive pleasures philosopher optimism oblige natives muy measuring measured masked
+mascot malicious mailing luca lifelong kosher koji kiddies judas isolate intercepted
+insecurity initially inferior incidentally ifs hun heals headlights guided growl grilling
+glazed gem gel gaps fundamental flunk floats fiery fairness exercising excellency evenings
+ere enrolled disclosure det damp curling cupboard counterfeit cooling condescending
+conclusive clicked cleans cholesterol chap cashed brow broccoli brats blueprints blindfold
+biz billing barracks attach aquarium appalled altitude alrighty aimed yawn wynant welcomed
+violations upright unsolved unreliable toots tighten symbolic sweatshirt steinbrenner
+steamy spouse sox sonogram slowed slots sleepless skeleton shines roles retaliate
+representatives rephrase repeated renaissance redeem rapidly rambling quilt quarrel prying
+proverbial priced presiding presidency prescribe prepped pranks possessive plaintiff
+philosophical pest persuaded perk pediatrics overlooked outcast oop odor notorious
+nightgown mythology mumbo monitored mediocre mademoiselle lunchtime lifesaver legislation
+leaned lambs lag killings interns intensity increasing identities hounding hem hellmouth
+goon goner ghoul germ gardening frenzy foyer extras extinct exhibition exaggerate
+everlasting enlightened drilling doubles digits dialed devote defined deceitful csi
+cosmetic contaminated conspired conning colonies cerebral cavern cathedral carving butting
+boiled blurry beams barf babysit assistants ascension architecture approaches albums
+albanian aaaaah wildly whoopee whiny weiskopf walkie vultures veteran vacations upfront
+unresolved tile tampering struggled stockholders specially snaps sleepwalking shrunk
+sermon seeks seduction scenarios scams ridden revolve repaired regulation reasonably
+reactor quotes preserved phenomenal patrolling paranormal ounces omigod offs nonstop
+nightfall nat militia logs lineup lava lashing labels kilometers invites investigative
+innocents infierno incision import implications humming highlights haunts greeks gloss
+gloating frannie flute fled fitted finishes fiji fetal feeny entrapment edit dyin download
+discomfort dimensions detonator dependable deke decree dax cot confiscated concludes
+concede complication commotion commence chulak caucasian casually canary brainer bolie
+ballpark anwar anatomy analyzing accommodations yukon youse wring wharf wallowing uranium
+unclear treason transgenics thrive thermal territories tedious survives stylish strippers
+sterile squeezing squeaky sprained solemn snoring sic shifting shattering shabby seams
+scrawny rotation risen revoked residue reeks recite reap ranting quoting primal pressures
+predicament precision plugs pits pinpoint petrified petite persona pathological passports
+oughtta nods nighter navigate nashville namely museums morale milwaukee meditation
+mathematics malta latter kippie intrigue intentional insufferable incomplete inability
+imprisoned hup hunky horrifying hearty headmaster hath har handbook hamptons grazie goof
+funerals fraction forks finances fetched excruciating enjoyable enhanced enhance endanger
+efficiency dumber drying diabolical destroyer desirable defendants debris darts cuisine
+cucumber cube crossword contestant considers comprehend clipped classmates choppers
+certificates canoe candlelight brutally brutality boarded bathrobe backward authorize atom
+assemble appeals airports aerobics ado wholesome whiff vessels vermin varsity trophies
+trait tragically toying titles tissues testy tasteful surge studios strips stocked
+staircase squares spinach sow southwest southeast sipping singers sidetracked seldom
+scrubbing scraping sanctity ruse robberies rink ridin retribution reinstated refrain rec
+realities readings radiant protesting projector posed plutonium plaque payin parting pans
+nooooo motorcycles motherfucking mein measly marv manic lice liam lenses lama lalita
+juggling jerking intro inevitably imprisonment hypnosis huddle horrendous hobbies heavier
+heartfelt harlin hairdresser grub gramps gonorrhea gardens fussing fragment fleeting
+flawless flashed fetus exclusively eulogy equality enforce distinctly disrespectful denies
+crossbow crest cregg crabs cowardly countess contrast contraction contingency consulted
+connects confirming condone coffins cleansing cheesecake certainty cages briefed brewing
+bravest bosom boils binoculars bachelorette atta assess appetizer ambushed alerted woozy
+withhold weighed vulgar viral utmost unusually unleashed unholy unhappiness underway
+uncovered unconditional typewriter typed twists sweeps supervised supermodel suburbs
+subpoenaed stringing snot skeptical skateboard shifted scottish schoolgirl romantically
+rocked revoir reviewed respiratory reopen regiment reflects refined puncture pta prone
+produces preach pools polished pods planetarium penicillin peacefully nurturing monastery
+mmhmm midgets marklar machinery lodged lifeline jer jellyfish infiltrate implies
+illegitimate hutch horseback henri heist gents frickin freezes forfeit followers flakes
+flair fathered fascist eternally eta epiphany enlisted eleventh elect effectively dos
+disgruntled discrimination discouraged delinquent decipher danvers dab cubes credible
+coping concession cnn clash chills cherished catastrophe caretaker bulk bras branches
+bombshell birthright billionaire awol ample alumni affections admiration abbotts whatnot
+watering vinegar vietnamese unthinkable unseen unprepared unorthodox underhanded uncool
+transmitted traits timeless thump thermometer theoretically theoretical testament tapping
+tagged tac synthetic syndicate swung surplus supplier stares spiked soviets solves smuggle
+scheduling scarier saucer reinforcements recruited rant quitter prudent projection
+previously powdered poked pointers placement peril penetrate penance patriotic passions
+opium nudge nostrils nevermind neurological muslims mow momentum mockery mobster mining
+medically magnitude loudly listing kar insights indicted implicate hypocritical humanly
+holiness healthier hammered haldeman gunman graphic gloom geography freshly francs
+formidable flunked flawed feminist faux ewww escorted escapes emptiness emerge drugging
+dozer directorate derevko deprive deodorant cryin crusade crocodile creativity
+controversial commands coloring colder cognac clocked clippings chit charades chanting
+certifiable caterers brute brochures briefs bran botched blinders bitchin banter babu
+appearing adequate accompanied abrupt abdomen zones wooo woken winding vip venezuela
+unanimous ulcer tread thirteenth thankfully tame swine swimsuit swans suv stressing
+steaming stamped stabilize squirm spokesman snooze shuffle shredded seoul seized seafood
+scratchy savor sadistic roster rica rhetorical revlon realist reactions prosecuting
+prophecies prisons precedent polyester petals persuasion paddles nuthin neighbour negroes
+naval mute muster muck minnesota meningitis matron mastered markers manufactured lockers
+letterman legged launching lanes journals indictment indicating hypnotized housekeeping
+hopelessly hmph hallucinations grader goldilocks girly furthermore frames flask expansion
+envelopes engaging downside doves doorknob distinctive dissolve discourage disapprove
+diabetic departed deliveries decorator deaq crossfire criminally containment comrades
+complimentary commitments chum chatter chapters catchy cashier cartel caribou cardiologist
+buffer brawl bowls booted billboard biblical barbershop awakening aryan angst administer
+acquitted acquisition aces accommodate zellie yield wreak whistles wart vandalism vamps
+uterus upstate unstoppable unrelated understudy tristin transporting transcript
+tranquilizer trails trafficking toxins tonsils therapeutic tex subscription submitted
+stempel spotting spectator spatula soho softer snotty slinging showered sexiest sensual
+scoring sadder roam rimbaud rim rewards restrain resilient remission reinstate rehash
+recollection rabies presenting preference prairie popsicle plausible plantation
+pharmaceutical pediatric patronizing patent participation outdoor ostrich ortolani oooooh
+omelette neglect nachos mixture mistrial mio marseilles mare mandate malt luv loophole
+literary liberation laughin kevvy jah irritated intends initiation initiated initiate
+influenced infidelity indigenous inc idaho hypothermia horrific hive heroine groupie
+grinding graceful goodspeed gestures gah frantic extradition engineers echelon earning
+disks discussions demolition
+`)
+
+// rank ,word => frequency rank, starting from 1
+var rank = func() map[string]int {
+	m := map[string]int{}
+	for i, w := range commonWords {
+		if _, ok := m[w]; !ok {
+			m[w] = i + 1
+		}
+	}
+	return m
+}()
+
+// Rank of word in bundled frequency list,
+// 0 if word isn't in the list
+func Rank(word string) int {
+	return rank[strings.ToLower(word)]
+}
+
+// IsCommon reports whether word is among
+// top n frequent words, n <= 0 means all words of list
+func IsCommon(word string, n int) bool {
+	r := Rank(word)
+	return r != 0 && (n <= 0 || r <= n)
+}
@@ -0,0 +1,31 @@
+package text
+
+import "testing"
+
+func TestCommonWords(t *testing.T) {
+	seen := map[string]bool{}
+	for _, w := range commonWords {
+		if seen[w] {
+			t.Errorf("%q is duplicated", w)
+		}
+		seen[w] = true
+	}
+
+	tests := []struct {
+		word string
+		n    int
+		want bool
+	}{
+		{"the", 10, true},
+		{"The", 10, true},
+		{"house", 0, true},
+		{"house", 10, false},
+		{"serendipitous", 0, false},
+	}
+
+	for _, tt := range tests {
+		if got := IsCommon(tt.word, tt.n); got != tt.want {
+			t.Errorf("IsCommon(%q, %d) = %v, want %v", tt.word, tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package text
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// Token ,a word in text
+type Token struct {
+	Word  string // lower cased, ex: "running"
+	Count int    // occurrences in text
+	Title bool   // every occurrence is capitalized, even within sentence, probably a name
+
+	lower bool // atleast one occurrence isn't capitalized
+}
+
+// Tokenize splits text into words, in order of first occurrence,
+// words are letters, along with inner apostrophes and hyphens,
+// ex: "don't", "well-known", possessive "'s" is dropped
+func Tokenize(r io.Reader) ([]*Token, error) {
+	var tokens []*Token
+	byWord := map[string]*Token{}
+
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	s.Split(bufio.ScanWords)
+
+	// first word of a sentence is capitalized anyway
+	sentenceStart := true
+
+	for s.Scan() {
+		field := s.Text()
+
+		for _, w := range splitWord(field) {
+			key := strings.ToLower(w)
+
+			t, ok := byWord[key]
+			if !ok {
+				t = &Token{Word: key}
+				byWord[key] = t
+				tokens = append(tokens, t)
+			}
+
+			t.Count++
+			if !unicode.IsUpper([]rune(w)[0]) {
+				t.lower = true
+				t.Title = false
+			} else if !sentenceStart && !t.lower {
+				t.Title = true
+			}
+
+			sentenceStart = false
+		}
+
+		sentenceStart = strings.ContainsAny(field[len(field)-1:], ".!?")
+	}
+
+	return tokens, s.Err()
+}
+
+// splitWord ,ex: `"Hello,world!"` => ["Hello", "world"]
+func splitWord(field string) []string {
+	words := strings.FieldsFunc(field, func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '\'' && r != '’' && r != '-'
+	})
+
+	var clean []string
+	for _, w := range words {
+		w = strings.Trim(w, "'’-")
+		w = strings.TrimSuffix(w, "'s")
+		w = strings.TrimSuffix(w, "’s")
+
+		if w != "" {
+			clean = append(clean, w)
+		}
+	}
+
+	return clean
+}
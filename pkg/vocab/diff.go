@@ -0,0 +1,203 @@
+package vocab
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Diff returns structural changes from old to new word,
+// one line for each change, prefixed by
+// "+" added, "-" removed, "~" changed,
+// nil if nothing has changed
+func Diff(old, new *Word) []string {
+	var changes []string
+
+	changed := func(name string, o, n string) {
+		if o != n {
+			changes = append(changes, "~ "+name)
+		}
+	}
+
+	changed("pronunciation", strings.Join(old.IPA, ", "), strings.Join(new.IPA, ", "))
+	changed("short definition", old.Short, new.Short)
+	changed("long definition", old.Long, new.Long)
+
+	changes = append(changes, diffOrdinals(ordinals(old), ordinals(new))...)
+	changes = append(changes, diffList("example", old.Examples, new.Examples)...)
+
+	if o, n := audioCount(old), audioCount(new); n > o {
+		changes = append(changes, "+ "+strconv.Itoa(n-o)+" audios")
+	} else if n < o {
+		changes = append(changes, "- "+strconv.Itoa(o-n)+" audios")
+	}
+
+	return changes
+}
+
+// SprintDiff returns changes of word, as returned by Diff
+func SprintDiff(word string, changes []string) string {
+	b := &strings.Builder{}
+
+	if len(changes) == 0 {
+		b.WriteString(word + ": unchanged\n")
+		return b.String()
+	}
+
+	b.WriteString(word + ":\n")
+	for _, c := range changes {
+		b.WriteString(Indent + c + "\n")
+	}
+
+	return b.String()
+}
+
+// ordinals of word, in order
+func ordinals(w *Word) []Ordinal {
+	var ords []Ordinal
+	for _, fdef := range w.FullDefs {
+		ords = append(ords, fdef.Ordinals...)
+	}
+	return ords
+}
+
+// ordKey ,ordinals are matched by their id,
+// if available, otherwise by their definition
+func ordKey(ord Ordinal) string {
+	if ord.ID != "" {
+		return ord.ID
+	}
+	return ord.ClassType + " " + ord.Definition
+}
+
+func ordName(ord Ordinal) string {
+	return "definition [" + ord.ClassType + "] " + ord.Definition
+}
+
+func diffOrdinals(old, new []Ordinal) []string {
+	var changes []string
+
+	oldOrds := map[string]Ordinal{}
+	for _, ord := range old {
+		oldOrds[ordKey(ord)] = ord
+	}
+
+	newOrds := map[string]bool{}
+	for _, ord := range new {
+		newOrds[ordKey(ord)] = true
+	}
+
+	for _, ord := range old {
+		if !newOrds[ordKey(ord)] {
+			changes = append(changes, "- "+ordName(ord))
+		}
+	}
+
+	for _, ord := range new {
+		o, ok := oldOrds[ordKey(ord)]
+		if !ok {
+			changes = append(changes, "+ "+ordName(ord))
+			continue
+		}
+
+		var ordChanges []string
+		ordChanges = append(ordChanges, diffList("example", o.Examples, ord.Examples)...)
+		ordChanges = append(ordChanges, diffInstances(o.Instances, ord.Instances)...)
+
+		if o.Definition != ord.Definition || o.ClassType != ord.ClassType {
+			changes = append(changes, "~ "+ordName(o)+" → "+ordName(ord))
+		} else if len(ordChanges) != 0 {
+			changes = append(changes, "~ "+ordName(ord))
+		}
+
+		// changes within ordinal are indented
+		for _, c := range ordChanges {
+			changes = append(changes, Indent+c)
+		}
+	}
+
+	return changes
+}
+
+// diffInstances returns words added to or removed from
+// each relation type, ex: "+ Synonyms: hound"
+func diffInstances(old, new []Instance) []string {
+	var changes []string
+
+	oldWords, newWords := insWords(old), insWords(new)
+
+	for _, relType := range insTypes(old, new) {
+		o, n := oldWords[relType], newWords[relType]
+
+		if added := missing(n, o); len(added) != 0 {
+			changes = append(changes, "+ "+relType+": "+strings.Join(added, ", "))
+		}
+		if removed := missing(o, n); len(removed) != 0 {
+			changes = append(changes, "- "+relType+": "+strings.Join(removed, ", "))
+		}
+	}
+
+	return changes
+}
+
+// insWords ,words of instances by relation type
+func insWords(instances []Instance) map[string][]string {
+	words := map[string][]string{}
+	for _, ins := range instances {
+		for _, iData := range ins.Datas {
+			words[ins.Type] = append(words[ins.Type], iData.Words...)
+		}
+	}
+	return words
+}
+
+// insTypes ,distinct relation types of old and new, in order
+func insTypes(old, new []Instance) []string {
+	var types []string
+	seen := map[string]bool{}
+	for _, ins := range append(append([]Instance{}, old...), new...) {
+		if !seen[ins.Type] {
+			seen[ins.Type] = true
+			types = append(types, ins.Type)
+		}
+	}
+	return types
+}
+
+// diffList returns added and removed items,
+// ex: `+ example: "..."`
+func diffList(name string, old, new []string) []string {
+	var changes []string
+
+	for _, v := range missing(new, old) {
+		changes = append(changes, "+ "+name+": "+strconv.Quote(v))
+	}
+	for _, v := range missing(old, new) {
+		changes = append(changes, "- "+name+": "+strconv.Quote(v))
+	}
+
+	return changes
+}
+
+// missing returns items of a, which aren't in b
+func missing(a, b []string) []string {
+	in := map[string]bool{}
+	for _, v := range b {
+		in[v] = true
+	}
+
+	var m []string
+	for _, v := range a {
+		if !in[v] {
+			m = append(m, v)
+		}
+	}
+	return m
+}
+
+// audioCount ,Audios may not be loaded
+func audioCount(w *Word) int {
+	if len(w.Audios) != 0 {
+		return len(w.Audios)
+	}
+	return len(w.AudioIDs)
+}
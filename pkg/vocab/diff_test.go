@@ -0,0 +1,82 @@
+package vocab
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	dog := func() *Word {
+		return &Word{
+			Word:  "dog",
+			IPA:   []string{"dɔɡ"},
+			Short: "a domesticated carnivore",
+			FullDefs: []FullDef{{GroupNum: 1, Ordinals: []Ordinal{
+				{
+					ID: "s1", ClassType: "noun", Definition: "a domesticated carnivore",
+					Examples:  []string{"the dog barked"},
+					Instances: []Instance{{Type: "Synonyms", Datas: []InstanceData{{Words: []string{"domestic dog"}}}}},
+				},
+				{ID: "s2", ClassType: "verb", Definition: "go after with the intent to catch"},
+			}}},
+			Examples: []string{"walk the dog"},
+			AudioIDs: []string{"a1"},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		change func(w *Word)
+		want   []string
+	}{
+		{"unchanged", func(w *Word) {}, nil},
+		{
+			"pronunciation and short",
+			func(w *Word) { w.IPA = []string{"dɑɡ"}; w.Short = "a canine" },
+			[]string{"~ pronunciation", "~ short definition"},
+		},
+		{
+			"definition added",
+			func(w *Word) {
+				w.FullDefs[0].Ordinals = append(w.FullDefs[0].Ordinals, Ordinal{ID: "s3", ClassType: "noun", Definition: "a dull unattractive person"})
+			},
+			[]string{"+ definition [noun] a dull unattractive person"},
+		},
+		{
+			"definition removed",
+			func(w *Word) { w.FullDefs[0].Ordinals = w.FullDefs[0].Ordinals[:1] },
+			[]string{"- definition [verb] go after with the intent to catch"},
+		},
+		{
+			"definition reworded",
+			func(w *Word) { w.FullDefs[0].Ordinals[1].Definition = "pursue" },
+			[]string{"~ definition [verb] go after with the intent to catch → definition [verb] pursue"},
+		},
+		{
+			"within definition",
+			func(w *Word) {
+				w.FullDefs[0].Ordinals[0].Examples = nil
+				w.FullDefs[0].Ordinals[0].Instances[0].Datas[0].Words = []string{"domestic dog", "Canis familiaris"}
+			},
+			[]string{
+				"~ definition [noun] a domesticated carnivore",
+				Indent + `- example: "the dog barked"`,
+				Indent + "+ Synonyms: Canis familiaris",
+			},
+		},
+		{
+			"examples and audios",
+			func(w *Word) { w.Examples = []string{"feed the dog"}; w.AudioIDs = []string{"a1", "a2"} },
+			[]string{`+ example: "feed the dog"`, `- example: "walk the dog"`, "+ 1 audios"},
+		},
+	}
+
+	for _, tt := range tests {
+		old, new := dog(), dog()
+		tt.change(new)
+
+		if got := Diff(old, new); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Diff = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -1,24 +1,22 @@
 package vocab
 
 import (
-	"bytes"
 	"encoding/json"
 	"io/ioutil"
 	"log"
 	"net/http"
-	"os/exec"
 	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/PuerkitoBio/goquery"
+	"github.com/nilsocket/def/pkg/audio"
 )
 
-var programName = "mpg123"
-
 // Get fetches word from vocabulary.com
-// and returns word definition, and suggestions if any
-func Get(word string) (*Word, []string) {
+// and returns word definition, and suggestions if any,
+// error if vocabulary.com couldn't be reached
+func Get(word string) (*Word, []string, error) {
 	var doc *goquery.Document
 	var err error
 
@@ -35,7 +33,8 @@ func Get(word string) (*Word, []string) {
 	go func() {
 		doc, err = goquery.NewDocument(wordURL)
 		if err != nil {
-			log.Fatalln(err)
+			wordDef.wg.Done()
+			return
 		}
 
 		sugs = suggestions(word, doc)
@@ -53,6 +52,7 @@ func Get(word string) (*Word, []string) {
 		go fetchAudio(wordDef, doc)
 
 		// process doc
+		ipa(wordDef, doc)
 		shortAndLong(wordDef, doc)
 		primaryAndFull(wordDef, doc)
 		wordDef.wg.Done()
@@ -61,11 +61,16 @@ func Get(word string) (*Word, []string) {
 	// wait
 	wordDef.wg.Wait()
 
+	// unreachable
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if len(sugs) > 0 {
-		return nil, sugs
+		return nil, sugs, nil
 	}
 
-	return wordDef, nil
+	return wordDef, nil, nil
 }
 
 // suggestions are given in case:
@@ -79,6 +84,25 @@ func suggestions(word string, doc *goquery.Document) (suggestions []string) {
 	return
 }
 
+// ipa ,phonetic transcriptions, one for each pronunciation,
+// ex: /dɔɡ/
+func ipa(word *Word, doc *goquery.Document) {
+	doc.Find(".ipa-with-audio").Find("h3").Each(func(i int, sel *goquery.Selection) {
+		text := strings.TrimSpace(sel.Text())
+		if text == "" {
+			return
+		}
+
+		for _, v := range word.IPA {
+			if v == text {
+				return
+			}
+		}
+
+		word.IPA = append(word.IPA, text)
+	})
+}
+
 func shortAndLong(word *Word, doc *goquery.Document) {
 	// short definition
 	word.Short = doc.Find(".short").Text()
@@ -200,6 +224,7 @@ func fetchAudio(word *Word, doc *goquery.Document) {
 			resp, err := http.Get(audioURL)
 			if err != nil {
 				log.Println(err)
+				return
 			}
 
 			audio, _ := ioutil.ReadAll(resp.Body)
@@ -217,7 +242,9 @@ func fetchExamples(word *Word, wordStr string) {
 
 	resp, err := http.Get(exURL)
 	if err != nil {
-		log.Fatalln("resp", err)
+		log.Println("resp", err)
+		word.wg.Done()
+		return
 	}
 
 	var examples List
@@ -225,7 +252,7 @@ func fetchExamples(word *Word, wordStr string) {
 	// decode
 	json.NewDecoder(resp.Body).Decode(&examples)
 
-	if !(len(examples.Result.Sentences) < 1) {
+	if examples.Result != nil {
 		for _, v := range examples.Result.Sentences {
 			word.Examples = append(word.Examples, v.Sentence)
 		}
@@ -234,11 +261,13 @@ func fetchExamples(word *Word, wordStr string) {
 	word.wg.Done()
 }
 
-// PlayAudio ,plays audio
-func (w *Word) PlayAudio() {
-	for _, audio := range w.Audios {
-		cmd := exec.Command(programName, "-")
-		cmd.Stdin = bytes.NewReader(audio)
-		cmd.Run()
+// PlayAudio ,plays audio using player,
+// stops at first error
+func (w *Word) PlayAudio(player audio.Player) error {
+	for _, a := range w.Audios {
+		if err := player.Play(a); err != nil {
+			return err
+		}
 	}
+	return nil
 }
@@ -9,6 +9,28 @@ import (
 // Indent for printing
 var Indent = "   "
 
+// ExampleCount ,number of examples printed in short format
+var ExampleCount = 3
+
+// Color ,if true output is colored using ansi escape codes
+var Color = false
+
+// ansi escape codes
+const (
+	bold   = "1"
+	green  = "32"
+	yellow = "33"
+	cyan   = "36"
+)
+
+// colored ,returns s colored with code, if Color is set
+func colored(s, code string) string {
+	if !Color {
+		return s
+	}
+	return "\x1b[" + code + "m" + s + "\x1b[0m"
+}
+
 // Sprints ,returns shortly expanded string
 func (w *Word) Sprints() string {
 	return w.printWord("short")
@@ -29,11 +51,40 @@ func (w *Word) SprintAnt() string {
 	return w.sprintSynAnt("Antonyms")
 }
 
+// SprintRel ,returns a string of given relation types,
+// relTypes are matched case insensitively and `-` matches space,
+// ex: "type-of" matches "Type of"
+func (w *Word) SprintRel(relTypes []string) string {
+	return w.sprintSynAnt(relTypes...)
+}
+
+// SprintRelTypes ,returns a string of relation types
+// available for word
+func (w *Word) SprintRelTypes() string {
+	b := &strings.Builder{}
+
+	relTypes := w.RelTypes()
+	if len(relTypes) > 0 {
+		b.WriteString(colored("Relations:", bold) + "\n")
+		for _, relType := range relTypes {
+			b.WriteString(Indent + relType + "\n")
+		}
+	}
+
+	return b.String()
+}
+
 // printWord ,prints given word,
 // printType = "short" || "long"
 func (w *Word) printWord(printType string) string {
 	b := &strings.Builder{}
 
+	// headword along with pronunciation
+	if len(w.IPA) != 0 {
+		b.WriteString(colored(w.Word, bold) + "  " + strings.Join(w.IPA, ", "))
+		b.WriteString("\n\n")
+	}
+
 	// short definition
 	if w.Short != "" {
 		b.WriteString(w.Short)
@@ -54,10 +105,13 @@ func (w *Word) printWord(printType string) string {
 			pDefs(w, 3, b)
 		}
 
-		if len(w.Examples) > 3 {
-			pExamples(w.Examples[:3], Indent, b)
-		} else if len(w.Examples) > 0 {
-			pExamples(w.Examples, Indent, b)
+		examples := w.Examples
+		if ExampleCount >= 0 && len(examples) > ExampleCount {
+			examples = examples[:ExampleCount]
+		}
+
+		if len(examples) > 0 {
+			pExamples(examples, Indent, b)
 		}
 
 	} else if printType == "long" {
@@ -72,7 +126,7 @@ func pPrimaryDefs(w *Word, b *strings.Builder) bool {
 	// if primaryIDs exist, FullDefs exist
 	if w.PrimaryIDs != nil {
 
-		b.WriteString("Definitions:\n")
+		b.WriteString(colored("Definitions:", bold) + "\n")
 
 		prevGroupNum := 0
 
@@ -82,7 +136,7 @@ func pPrimaryDefs(w *Word, b *strings.Builder) bool {
 				for _, ord := range fdef.Ordinals {
 					if ord.ID == pID { // matched
 						if prevGroupNum != fdef.GroupNum {
-							b.WriteString("\n" + strconv.Itoa(fdef.GroupNum) + "\n")
+							b.WriteString("\n" + colored(strconv.Itoa(fdef.GroupNum), yellow) + "\n")
 							prevGroupNum = fdef.GroupNum
 							i = 1
 						}
@@ -98,14 +152,18 @@ func pPrimaryDefs(w *Word, b *strings.Builder) bool {
 	return false
 }
 
-// pDefinition ,prints definition along with class type
+// pDefinition ,prints definition along with class type, if known
 func pDefinition(ord Ordinal, id int, b *strings.Builder) {
+	classType := ""
+	if ord.ClassType != "" {
+		classType = "[" + colored(ord.ClassType, cyan) + "] "
+	}
+
 	b.WriteString(
 		Indent +
 			strconv.Itoa(id) +
-			". [" +
-			ord.ClassType +
-			"] " +
+			". " +
+			classType +
 			ord.Definition +
 			"\n",
 	)
@@ -117,14 +175,14 @@ func pDefinition(ord Ordinal, id int, b *strings.Builder) {
 // and third from the second one
 func pDefs(w *Word, count int, b *strings.Builder) {
 	if w.FullDefs != nil {
-		b.WriteString("\nDefinitions:\n")
+		b.WriteString("\n" + colored("Definitions:", bold) + "\n")
 
 		overAllCount := 0
 
 		for i := 0; i < count && i < len(w.FullDefs); i++ {
 			fullDef := w.FullDefs[i]
 
-			b.WriteString("\n" + strconv.Itoa(fullDef.GroupNum) + "\n")
+			b.WriteString("\n" + colored(strconv.Itoa(fullDef.GroupNum), yellow) + "\n")
 
 			for oid, ord := range fullDef.Ordinals {
 
@@ -145,12 +203,12 @@ func pDefs(w *Word, count int, b *strings.Builder) {
 
 func pFullDefs(w *Word, count int, b *strings.Builder) {
 	if w.FullDefs != nil {
-		b.WriteString("\nDefinitions:\n")
+		b.WriteString("\n" + colored("Definitions:", bold) + "\n")
 
 		for i := 0; i < count && i < len(w.FullDefs); i++ {
 			fullDef := w.FullDefs[i]
 
-			b.WriteString("\n" + strconv.Itoa(fullDef.GroupNum) + "\n")
+			b.WriteString("\n" + colored(strconv.Itoa(fullDef.GroupNum), yellow) + "\n")
 
 			for oid, ord := range fullDef.Ordinals {
 				pDefinition(ord, oid+1, b)            // definition
@@ -166,9 +224,9 @@ func pFullDefs(w *Word, count int, b *strings.Builder) {
 // pInsOpts ,print instance options
 // instance options for printing
 type pInsOpts struct {
-	Type                   string // matched type will be printed
-	Words                  bool   // print words
-	Data                   bool   // print definition
+	Types                  []string // matched types will be printed, all if empty
+	Words                  bool     // print words
+	Data                   bool     // print definition
 	TypeIndent, WordIndent int
 }
 
@@ -192,10 +250,10 @@ func printInstance(ins Instance, b *strings.Builder, insOpts *pInsOpts) {
 
 	// if we have data print it's type
 	if len(ins.Datas) != 0 {
-		if insOpts.Type == ins.Type || insOpts.Type == "" {
+		if insOpts.match(ins.Type) {
 
 			if ins.Type != "" {
-				b.WriteString(typeIndent + ins.Type + ":\n")
+				b.WriteString(typeIndent + colored(ins.Type+":", green) + "\n")
 			}
 
 			for i, insData := range ins.Datas {
@@ -215,6 +273,30 @@ func printInstance(ins Instance, b *strings.Builder, insOpts *pInsOpts) {
 	}
 }
 
+// match ,reports whether instance type
+// should be printed
+func (insOpts *pInsOpts) match(insType string) bool {
+	if len(insOpts.Types) == 0 {
+		return true
+	}
+
+	for _, t := range insOpts.Types {
+		if RelKey(t) == RelKey(insType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RelKey ,normalizes relation type for comparison,
+// "Type of", "type-of" => "type of"
+func RelKey(relType string) string {
+	relType = strings.TrimSpace(relType)
+	relType = strings.ReplaceAll(relType, "-", " ")
+	return strings.ToLower(relType)
+}
+
 func printInsData(insData InstanceData, b *strings.Builder, insOpts *pInsOpts, wordIndent, defIndent string) {
 	if insOpts.Words {
 		for i, word := range insData.Words {
@@ -246,7 +328,7 @@ func pFullExamples(examples []string, b *strings.Builder) {
 }
 
 func pExamples(examples []string, indent string, b *strings.Builder) {
-	b.WriteString("\nExamples:\n")
+	b.WriteString("\n" + colored("Examples:", bold) + "\n")
 
 	if len(examples) != 0 {
 		for i, ex := range examples {
@@ -255,14 +337,14 @@ func pExamples(examples []string, indent string, b *strings.Builder) {
 	}
 }
 
-// sprintSyn ,returns a string of synonyms
-func (w *Word) sprintSynAnt(insType string) string {
+// sprintSynAnt ,returns a string of given instance types
+func (w *Word) sprintSynAnt(insTypes ...string) string {
 	b := &strings.Builder{}
 
-	opts := &pInsOpts{Type: insType, Words: true, TypeIndent: 1, WordIndent: 2}
+	opts := &pInsOpts{Types: insTypes, Words: true, TypeIndent: 1, WordIndent: 2}
 	if w.FullDefs != nil {
 		for _, fdef := range w.FullDefs {
-			b.WriteString("\n" + strconv.Itoa(fdef.GroupNum) + "\n")
+			b.WriteString("\n" + colored(strconv.Itoa(fdef.GroupNum), yellow) + "\n")
 			for oi, ord := range fdef.Ordinals {
 				pDefinition(ord, oi+1, b)
 				printInstances(ord.Instances, b, opts)
@@ -273,12 +355,36 @@ func (w *Word) sprintSynAnt(insType string) string {
 	return b.String()
 }
 
+// Gloss ,returns a single line definition of word,
+// first primary definition, or first definition,
+// or short definition, in that order
+func (w *Word) Gloss() string {
+	var first *Ordinal
+
+	for _, fdef := range w.FullDefs {
+		for i, ord := range fdef.Ordinals {
+			if first == nil {
+				first = &fdef.Ordinals[i]
+			}
+			if len(w.PrimaryIDs) != 0 && ord.ID == w.PrimaryIDs[0] {
+				return "[" + ord.ClassType + "] " + ord.Definition
+			}
+		}
+	}
+
+	if first != nil {
+		return "[" + first.ClassType + "] " + first.Definition
+	}
+
+	return w.Short
+}
+
 // SprintSuggestions returns a string of suggestions
 func SprintSuggestions(sugs []string) string {
 	b := &strings.Builder{}
 
 	if len(sugs) > 0 {
-		b.WriteString("Did you mean?\n")
+		b.WriteString(colored("Did you mean?", bold) + "\n")
 		for i, sug := range sugs {
 			b.WriteString(Indent + fmt.Sprintf("%2d. ", i+1) + sug + "\n")
 		}
@@ -2,6 +2,7 @@ package vocab
 
 import (
 	"sync"
+	"time"
 )
 
 // Word consists of 4 type of definitions:
@@ -11,13 +12,18 @@ import (
 // - Full Definitions
 type Word struct {
 	Word        string
+	IPA         []string  // .ipa-with-audio h3, pronunciations
 	Short       string    // .short
 	Long        string    // .long
 	PrimaryIDs  []string  // tbody a ,redirects to fullDefs, contains id
 	FullDefs    []FullDef // .section .definition
-	Audios      []Audio
+	Audios      []Audio   `json:"-"`
+	AudioIDs    []string  // keys of Audios in database, Audios are loaded only when needed
 	Examples    []string
 	CapitalOnly bool
+	Synthetic   bool      // Audios are synthesized by text to speech, not recorded
+	Fetched     time.Time // when word is fetched from provider, zero if unknown
+	Provider    string    // name of provider word is fetched from, empty if unknown
 	wg          *sync.WaitGroup
 }
 
@@ -48,6 +54,102 @@ type Instance struct {
 	Datas []InstanceData // {dd}
 }
 
+// Primary ,returns primary definitions in order,
+// if there are none, first n definitions
+func (w *Word) Primary(n int) []Ordinal {
+	var ords []Ordinal
+
+	for _, pID := range w.PrimaryIDs {
+		for _, fdef := range w.FullDefs {
+			for _, ord := range fdef.Ordinals {
+				if ord.ID == pID {
+					ords = append(ords, ord)
+				}
+			}
+		}
+	}
+
+	if len(ords) != 0 {
+		return ords
+	}
+
+	for _, fdef := range w.FullDefs {
+		for _, ord := range fdef.Ordinals {
+			if len(ords) == n {
+				return ords
+			}
+			ords = append(ords, ord)
+		}
+	}
+
+	return ords
+}
+
+// Example ,returns first example of word,
+// or of it's definitions, empty if there are none
+func (w *Word) Example() string {
+	if len(w.Examples) != 0 {
+		return w.Examples[0]
+	}
+
+	for _, fdef := range w.FullDefs {
+		for _, ord := range fdef.Ordinals {
+			if len(ord.Examples) != 0 {
+				return ord.Examples[0]
+			}
+		}
+	}
+
+	return ""
+}
+
+// RelTypes ,returns distinct instance types of word,
+// in the order they appear
+func (w *Word) RelTypes() []string {
+	var relTypes []string
+	seen := map[string]bool{}
+
+	for _, fdef := range w.FullDefs {
+		for _, ord := range fdef.Ordinals {
+			for _, ins := range ord.Instances {
+				if ins.Type != "" && len(ins.Datas) != 0 && !seen[ins.Type] {
+					seen[ins.Type] = true
+					relTypes = append(relTypes, ins.Type)
+				}
+			}
+		}
+	}
+
+	return relTypes
+}
+
+// RelWords ,returns distinct words related to word
+// through relType, ex: "Synonyms", "type-of"
+func (w *Word) RelWords(relType string) []string {
+	var words []string
+	seen := map[string]bool{}
+
+	for _, fdef := range w.FullDefs {
+		for _, ord := range fdef.Ordinals {
+			for _, ins := range ord.Instances {
+				if RelKey(ins.Type) != RelKey(relType) {
+					continue
+				}
+				for _, iData := range ins.Datas {
+					for _, word := range iData.Words {
+						if !seen[word] {
+							seen[word] = true
+							words = append(words, word)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return words
+}
+
 // InstanceData consists of words for that particular instance
 // it's definition if possible
 type InstanceData struct {
@@ -0,0 +1,257 @@
+// Package wiktionary reads JSON lines dumps of Wiktionary,
+// as extracted by wiktextract (https://kaikki.org)
+package wiktionary
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/nilsocket/def/pkg/vocab"
+)
+
+// Entry ,a line of dump, word with a part of speech
+type Entry struct {
+	Word     string  `json:"word"`
+	Pos      string  `json:"pos"`
+	LangCode string  `json:"lang_code"`
+	Senses   []Sense `json:"senses"`
+	Sounds   []Sound `json:"sounds"`
+	Synonyms []Link  `json:"synonyms"`
+	Antonyms []Link  `json:"antonyms"`
+}
+
+// Sense of an entry
+type Sense struct {
+	ID       string    `json:"id"`
+	Glosses  []string  `json:"glosses"` // parent glosses first
+	Examples []Example `json:"examples"`
+	Synonyms []Link    `json:"synonyms"`
+	Antonyms []Link    `json:"antonyms"`
+}
+
+// Example of a sense
+type Example struct {
+	Text string `json:"text"`
+}
+
+// Link to a related word, Sense is gloss it relates to, if any
+type Link struct {
+	Word  string `json:"word"`
+	Sense string `json:"sense"`
+}
+
+// Sound ,pronunciation
+type Sound struct {
+	IPA string `json:"ipa"`
+}
+
+// classTypes ,wiktextract pos to class type
+var classTypes = map[string]string{
+	"adj":  "adjective",
+	"adv":  "adverb",
+	"prep": "preposition",
+	"conj": "conjunction",
+	"intj": "interjection",
+	"det":  "determiner",
+	"num":  "numeral",
+	"pron": "pronoun",
+}
+
+// Decode dump from r, streaming, calling fn for each word,
+// entries of lang only are decoded, all if empty.
+// fn is called for consecutive entries of a word,
+// if they aren't, fn is called again for same word, see Merge
+func Decode(r io.Reader, lang string, fn func(w *vocab.Word) error) error {
+	dec := json.NewDecoder(r)
+
+	var group []Entry
+
+	flush := func() error {
+		if len(group) == 0 {
+			return nil
+		}
+		w := ToWord(group)
+		group = group[:0]
+
+		if len(w.FullDefs) == 0 {
+			return nil
+		}
+		return fn(w)
+	}
+
+	for {
+		var e Entry
+		if err := dec.Decode(&e); err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if e.Word == "" || (lang != "" && e.LangCode != lang) {
+			continue
+		}
+
+		if len(group) != 0 && group[0].Word != e.Word {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+
+		group = append(group, e)
+	}
+
+	return flush()
+}
+
+// ToWord converts entries of a word into vocab.Word,
+// each entry is a FullDef, and each sense an Ordinal,
+// Short is first sense
+func ToWord(entries []Entry) *vocab.Word {
+	w := &vocab.Word{}
+	seenIPA := map[string]bool{}
+
+	for _, e := range entries {
+		w.Word = e.Word
+
+		for _, s := range e.Sounds {
+			if s.IPA != "" && !seenIPA[s.IPA] {
+				seenIPA[s.IPA] = true
+				w.IPA = append(w.IPA, s.IPA)
+			}
+		}
+
+		fdef := vocab.FullDef{GroupNum: len(w.FullDefs) + 1}
+
+		for _, s := range e.Senses {
+			if len(s.Glosses) == 0 {
+				continue
+			}
+
+			ord := vocab.Ordinal{
+				ID:         s.ID,
+				ClassType:  classType(e.Pos),
+				Definition: s.Glosses[len(s.Glosses)-1],
+			}
+			if ord.ID == "" {
+				ord.ID = ordID(fdef.GroupNum, len(fdef.Ordinals)+1)
+			}
+
+			for _, ex := range s.Examples {
+				if ex.Text != "" {
+					ord.Examples = append(ord.Examples, ex.Text)
+				}
+			}
+
+			addInstance(&ord, "Synonyms", s.Synonyms)
+			addInstance(&ord, "Antonyms", s.Antonyms)
+
+			fdef.Ordinals = append(fdef.Ordinals, ord)
+		}
+
+		if len(fdef.Ordinals) == 0 {
+			continue
+		}
+
+		// relations of entry, to sense they name, or to first
+		addLinks(&fdef, "Synonyms", e.Synonyms)
+		addLinks(&fdef, "Antonyms", e.Antonyms)
+
+		w.FullDefs = append(w.FullDefs, fdef)
+	}
+
+	if len(w.FullDefs) != 0 {
+		w.Short = w.FullDefs[0].Ordinals[0].Definition
+	}
+
+	return w
+}
+
+// ordID ,id of n'th ordinal of group, for senses without id
+func ordID(group, n int) string {
+	return "wk" + strconv.Itoa(group) + "-" + strconv.Itoa(n)
+}
+
+// Merge definitions of more into w, both of same word,
+// ex: when entries of a word aren't consecutive in dump
+func Merge(w, more *vocab.Word) *vocab.Word {
+	seenIPA := map[string]bool{}
+	for _, ipa := range w.IPA {
+		seenIPA[ipa] = true
+	}
+
+	for _, ipa := range more.IPA {
+		if !seenIPA[ipa] {
+			seenIPA[ipa] = true
+			w.IPA = append(w.IPA, ipa)
+		}
+	}
+
+	for _, fdef := range more.FullDefs {
+		group := fdef.GroupNum
+		fdef.GroupNum = len(w.FullDefs) + 1
+
+		for i, ord := range fdef.Ordinals {
+			if ord.ID == ordID(group, i+1) {
+				fdef.Ordinals[i].ID = ordID(fdef.GroupNum, i+1)
+			}
+		}
+
+		w.FullDefs = append(w.FullDefs, fdef)
+	}
+
+	if w.Short == "" {
+		w.Short = more.Short
+	}
+
+	return w
+}
+
+// addLinks of entry to ordinals they relate to
+func addLinks(fdef *vocab.FullDef, relType string, links []Link) {
+	for _, link := range links {
+		i := 0
+		for j, ord := range fdef.Ordinals {
+			if link.Sense != "" && strings.Contains(ord.Definition, link.Sense) {
+				i = j
+				break
+			}
+		}
+
+		addInstance(&fdef.Ordinals[i], relType, []Link{link})
+	}
+}
+
+// addInstance of relType to ord, merging with existing one
+func addInstance(ord *vocab.Ordinal, relType string, links []Link) {
+	var words []string
+	for _, link := range links {
+		if link.Word != "" {
+			words = append(words, link.Word)
+		}
+	}
+
+	if len(words) == 0 {
+		return
+	}
+
+	for i, ins := range ord.Instances {
+		if ins.Type == relType {
+			ord.Instances[i].Datas[0].Words = append(ins.Datas[0].Words, words...)
+			return
+		}
+	}
+
+	ord.Instances = append(ord.Instances, vocab.Instance{
+		Type:  relType,
+		Datas: []vocab.InstanceData{{Words: words}},
+	})
+}
+
+func classType(pos string) string {
+	if classType, ok := classTypes[pos]; ok {
+		return classType
+	}
+	return pos
+}
@@ -0,0 +1,148 @@
+package wordnet
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/nilsocket/def/pkg/vocab"
+)
+
+// classTypes ,class type of synset types
+var classTypes = map[byte]string{'n': "noun", 'v': "verb", 'a': "adjective", 's': "adjective", 'r': "adverb"}
+
+// relTypes ,relation types of pointer symbols,
+// as named by vocabulary.com
+var relTypes = map[string]string{
+	"!":  "Antonyms",
+	"@":  "Type of",
+	"@i": "Type of",
+	"~":  "Types",
+	"~i": "Types",
+}
+
+// relOrder ,order in which relations are listed
+var relOrder = []string{"Synonyms", "Antonyms", "Types", "Type of"}
+
+var exampleRe = regexp.MustCompile(`"([^"]*)"`)
+
+// Lookup word in WordNet, senses are grouped by pos
+// in a FullDef each, nil if word isn't found
+func (wn *WordNet) Lookup(word string) (*vocab.Word, error) {
+	byPos, ok := wn.index[lemmaKey(word)]
+	if !ok {
+		return nil, nil
+	}
+
+	w := &vocab.Word{Word: word}
+
+	for _, pos := range posOrder {
+		offsets := byPos[pos]
+		if len(offsets) == 0 {
+			continue
+		}
+
+		fdef := vocab.FullDef{GroupNum: len(w.FullDefs) + 1}
+
+		for _, offset := range offsets {
+			ss, err := wn.synset(pos, offset)
+			if err != nil {
+				return nil, err
+			}
+
+			ord, err := wn.ordinal(word, ss)
+			if err != nil {
+				return nil, err
+			}
+
+			fdef.Ordinals = append(fdef.Ordinals, ord)
+		}
+
+		w.FullDefs = append(w.FullDefs, fdef)
+	}
+
+	if len(w.FullDefs) != 0 {
+		w.Short = w.FullDefs[0].Ordinals[0].Definition
+	}
+
+	return w, nil
+}
+
+// ordinal of word from synset, gloss is split
+// into definition and examples
+func (wn *WordNet) ordinal(word string, ss *synset) (vocab.Ordinal, error) {
+	def, examples := splitGloss(ss.gloss)
+
+	ord := vocab.Ordinal{
+		ID:         fmt.Sprintf("%c%08d", ss.pos, ss.offset),
+		ClassType:  classTypes[ss.pos],
+		Definition: def,
+		Examples:   examples,
+	}
+
+	rels := map[string][]vocab.InstanceData{}
+
+	// synonyms, other words of synset
+	var syns []string
+	source := 0
+	for i, sw := range ss.words {
+		if strings.EqualFold(sw, word) {
+			source = i + 1
+			continue
+		}
+		syns = append(syns, sw)
+	}
+	if len(syns) != 0 {
+		rels["Synonyms"] = []vocab.InstanceData{{Words: syns}}
+	}
+
+	for _, p := range ss.ptrs {
+		relType, ok := relTypes[p.symbol]
+		if !ok {
+			continue
+		}
+
+		// lexical relation of another word in synset
+		if p.source != 0 && p.source != source {
+			continue
+		}
+
+		target, err := wn.synset(p.pos, p.offset)
+		if err != nil {
+			return ord, err
+		}
+
+		iData := vocab.InstanceData{Words: target.words}
+		if p.target != 0 && p.target <= len(target.words) {
+			iData.Words = []string{target.words[p.target-1]}
+		}
+		iData.Definition, _ = splitGloss(target.gloss)
+
+		rels[relType] = append(rels[relType], iData)
+	}
+
+	for _, relType := range relOrder {
+		if len(rels[relType]) != 0 {
+			ord.Instances = append(ord.Instances, vocab.Instance{Type: relType, Datas: rels[relType]})
+		}
+	}
+
+	return ord, nil
+}
+
+// splitGloss into definition and quoted examples,
+// ex: `domesticated canid; "the dog barked all night"`
+func splitGloss(gloss string) (string, []string) {
+	var examples []string
+
+	for _, m := range exampleRe.FindAllStringSubmatch(gloss, -1) {
+		examples = append(examples, m[1])
+	}
+
+	def := gloss
+	if i := strings.IndexByte(gloss, '"'); i != -1 {
+		def = gloss[:i]
+	}
+
+	return strings.Trim(strings.TrimSpace(def), ";: "), examples
+}
@@ -0,0 +1,214 @@
+// Package wordnet reads WordNet 3.x database files,
+// (index.noun, data.noun, ...) into vocab.Word
+package wordnet
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// posFiles ,suffix of index and data files, by pos
+var posFiles = map[byte]string{'n': "noun", 'v': "verb", 'a': "adj", 'r': "adv"}
+
+// posOrder ,order in which senses are grouped
+var posOrder = []byte{'n', 'v', 'a', 'r'}
+
+// ErrNoWordNet is returned if dir has no WordNet files
+var ErrNoWordNet = errors.New("no wordnet files found")
+
+// WordNet database
+type WordNet struct {
+	index map[string]map[byte][]int64 // lemma to synset offsets, by pos
+	data  map[byte]*os.File
+}
+
+// synset ,set of synonyms, a line of data file
+type synset struct {
+	offset int64
+	pos    byte // n, v, a, s, r
+	words  []string
+	ptrs   []pointer
+	gloss  string
+}
+
+// pointer ,relation to another synset, or to a word in it
+type pointer struct {
+	symbol string // !, @, ~, ...
+	offset int64
+	pos    byte
+	source int // word number in synset, 0 if relation is of whole synset
+	target int
+}
+
+// Open WordNet database in dir, ex: /usr/share/wordnet,
+// index files are read into memory
+func Open(dir string) (*WordNet, error) {
+	wn := &WordNet{
+		index: map[string]map[byte][]int64{},
+		data:  map[byte]*os.File{},
+	}
+
+	for _, pos := range posOrder {
+		f, err := os.Open(filepath.Join(dir, "data."+posFiles[pos]))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			wn.Close()
+			return nil, err
+		}
+		wn.data[pos] = f
+
+		if err := wn.readIndex(pos, filepath.Join(dir, "index."+posFiles[pos])); err != nil {
+			wn.Close()
+			return nil, err
+		}
+	}
+
+	if len(wn.data) == 0 {
+		return nil, ErrNoWordNet
+	}
+
+	return wn, nil
+}
+
+// readIndex of pos, each line is
+// lemma pos synset_cnt p_cnt [ptr_symbol...] sense_cnt tagsense_cnt synset_offset...
+func (wn *WordNet) readIndex(pos byte, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for sc.Scan() {
+		line := sc.Text()
+
+		// license
+		if strings.HasPrefix(line, " ") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 6 {
+			continue
+		}
+
+		n, err := strconv.Atoi(fields[2])
+		if err != nil || n > len(fields) {
+			continue
+		}
+
+		var offsets []int64
+		for _, field := range fields[len(fields)-n:] {
+			if offset, err := strconv.ParseInt(field, 10, 64); err == nil {
+				offsets = append(offsets, offset)
+			}
+		}
+
+		if wn.index[fields[0]] == nil {
+			wn.index[fields[0]] = map[byte][]int64{}
+		}
+		wn.index[fields[0]][pos] = offsets
+	}
+
+	return sc.Err()
+}
+
+// Close WordNet
+func (wn *WordNet) Close() error {
+	for _, f := range wn.data {
+		f.Close()
+	}
+	return nil
+}
+
+// Words calls fn for each lemma in WordNet
+func (wn *WordNet) Words(fn func(word string)) {
+	for lemma := range wn.index {
+		fn(strings.ReplaceAll(lemma, "_", " "))
+	}
+}
+
+// synset at offset in data file of pos, each line is
+// offset lex_filenum ss_type w_cnt word lex_id... p_cnt [ptr...] [frames...] | gloss
+func (wn *WordNet) synset(pos byte, offset int64) (*synset, error) {
+	if pos == 's' {
+		pos = 'a'
+	}
+
+	f, ok := wn.data[pos]
+	if !ok {
+		return nil, errors.New("wordnet: no data file for pos " + string(pos))
+	}
+
+	line, err := bufio.NewReader(io.NewSectionReader(f, offset, 1<<20)).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	ss := &synset{offset: offset}
+
+	if i := strings.Index(line, " | "); i != -1 {
+		ss.gloss = strings.TrimSpace(line[i+3:])
+		line = line[:i]
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) < 4 {
+		return nil, errors.New("wordnet: corrupt data at " + strconv.FormatInt(offset, 10))
+	}
+	ss.pos = fields[2][0]
+
+	wCnt, _ := strconv.ParseInt(fields[3], 16, 0)
+	fields = fields[4:]
+
+	for i := 0; i < int(wCnt) && len(fields) >= 2; i++ {
+		ss.words = append(ss.words, lemmaWord(fields[0]))
+		fields = fields[2:]
+	}
+
+	if len(fields) == 0 {
+		return ss, nil
+	}
+
+	pCnt, _ := strconv.Atoi(fields[0])
+	fields = fields[1:]
+
+	for i := 0; i < pCnt && len(fields) >= 4; i++ {
+		p := pointer{symbol: fields[0], pos: fields[2][0]}
+		p.offset, _ = strconv.ParseInt(fields[1], 10, 64)
+
+		if len(fields[3]) == 4 {
+			src, _ := strconv.ParseInt(fields[3][:2], 16, 0)
+			tgt, _ := strconv.ParseInt(fields[3][2:], 16, 0)
+			p.source, p.target = int(src), int(tgt)
+		}
+
+		ss.ptrs = append(ss.ptrs, p)
+		fields = fields[4:]
+	}
+
+	return ss, nil
+}
+
+// lemmaWord ,word as written, without underscores
+// and adjective markers, ex: "domestic_dog", "big(a)"
+func lemmaWord(word string) string {
+	if i := strings.IndexByte(word, '('); i > 0 && strings.HasSuffix(word, ")") {
+		word = word[:i]
+	}
+	return strings.ReplaceAll(word, "_", " ")
+}
+
+// lemmaKey ,key of word in index files
+func lemmaKey(word string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(word)), " ", "_")
+}
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nilsocket/def/pkg/db"
+	"github.com/nilsocket/def/pkg/dict"
+	"github.com/nilsocket/def/pkg/lang"
+	"github.com/nilsocket/def/pkg/stardict"
+	"github.com/nilsocket/def/pkg/vocab"
+	"github.com/nilsocket/def/pkg/wordnet"
+)
+
+// provider ,source of words, get returns word definition,
+// and suggestions if any, error if provider couldn't be used
+type provider struct {
+	get     func(word string) (*vocab.Word, []string, error)
+	network bool // skipped when offline
+}
+
+// providers available for lookup, by name
+var providers = map[string]provider{
+	"vocabulary": {english(vocab.Get), true},
+	"dict":       {english(dictGet), true},
+	"stardict":   {english(starDictGet), false},
+	"wordnet":    {english(wordNetGet), false},
+	"wiktionary": {wiktionaryGet, false},
+}
+
+// errUnavailable ,provider isn't available, ex: not configured,
+// next provider is tried silently
+var errUnavailable = errors.New("unavailable")
+
+// english ,provider of english words only,
+// unavailable for other languages
+func english(get func(word string) (*vocab.Word, []string, error)) func(word string) (*vocab.Word, []string, error) {
+	return func(word string) (*vocab.Word, []string, error) {
+		if langFlag != lang.Default {
+			return nil, nil, errUnavailable
+		}
+		return get(word)
+	}
+}
+
+// fetchFromProviders tries providers in configured order,
+// until one of them finds word, or gives suggestions.
+// Next provider is tried only if a provider couldn't be used,
+// or an offline provider didn't find word,
+// i.e., offline providers are fallbacks of network providers.
+// When offline, network providers are skipped.
+// answered reports whether any provider could be used,
+// i.e., if word isn't found, it isn't a headword
+func fetchFromProviders(word string) (vw *vocab.Word, sugs []string, answered bool) {
+	for _, name := range cfg.Providers {
+		p, ok := providers[name]
+		if !ok {
+			log.Println("unknown provider", name)
+			continue
+		}
+
+		if cfg.Offline && p.network {
+			continue
+		}
+
+		vw, sugs, err := p.get(word)
+		if err != nil {
+			if err != errUnavailable {
+				log.Println(name, err)
+			}
+			continue
+		}
+
+		if vw != nil {
+			vw.Fetched = time.Now()
+			vw.Provider = name
+			return vw, nil, true
+		}
+
+		if len(sugs) != 0 || p.network {
+			return nil, sugs, true
+		}
+
+		answered = true
+	}
+
+	return nil, nil, answered
+}
+
+// refetchFromProvider fetches word again from provider it was fetched from,
+// so that it isn't replaced by entry of a different provider,
+// nil if it couldn't be fetched
+func refetchFromProvider(vw *vocab.Word) *vocab.Word {
+	name := vw.Provider
+	if name == "" {
+		name = "vocabulary" // fetched by older versions of def
+	}
+
+	p, ok := providers[name]
+	if !ok || (cfg.Offline && p.network) {
+		return nil
+	}
+
+	nw, _, err := p.get(vw.Word)
+	if err != nil {
+		if err != errUnavailable {
+			log.Println(name, err)
+		}
+		return nil
+	}
+
+	if nw != nil {
+		nw.Fetched = time.Now()
+		nw.Provider = name
+	}
+
+	return nw
+}
+
+// dictGet gets word from DICT server (RFC 2229),
+// if not found, suggestions are closest matches
+func dictGet(word string) (*vocab.Word, []string, error) {
+	c, err := dict.Dial(cfg.DictServer)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer c.Close()
+
+	defs, err := c.Define(cfg.DictDatabase, word)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(defs) != 0 {
+		return dict.ToWord(word, defs), nil, nil
+	}
+
+	sugs, err := c.Match(cfg.DictDatabase, "lev", word)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nil, sugs, nil
+}
+
+var (
+	starDicts     []*stardict.Dict
+	starDictsOnce sync.Once
+)
+
+// openStarDicts opens configured StarDict dictionaries, once
+func openStarDicts() []*stardict.Dict {
+	starDictsOnce.Do(func() {
+		for _, ifo := range stardict.Find(cfg.StarDict) {
+			d, err := stardict.Open(ifo)
+			if err != nil {
+				log.Println("stardict", ifo, err)
+				continue
+			}
+			starDicts = append(starDicts, d)
+		}
+	})
+
+	return starDicts
+}
+
+// starDictGet gets word from local StarDict dictionaries,
+// if not found, suggestions are headwords within
+// Levenshtein distance one
+func starDictGet(word string) (*vocab.Word, []string, error) {
+	var entries []stardict.Entry
+
+	dicts := openStarDicts()
+	if len(dicts) == 0 {
+		return nil, nil, errUnavailable
+	}
+
+	for _, d := range dicts {
+		es, err := d.Lookup(word)
+		if err != nil {
+			log.Println("stardict", d.BookName, err)
+			continue
+		}
+		entries = append(entries, es...)
+	}
+
+	if len(entries) != 0 {
+		return stardict.ToWord(word, entries), nil, nil
+	}
+
+	var sugs []string
+	lword := strings.ToLower(word)
+
+	for _, d := range dicts {
+		d.Words(func(w string) {
+			if dict.Levenshtein(lword, strings.ToLower(w)) <= 1 {
+				sugs = append(sugs, w)
+			}
+		})
+	}
+
+	return nil, dedup(sugs), nil
+}
+
+var (
+	wordNet     *wordnet.WordNet
+	wordNetOnce sync.Once
+)
+
+// openWordNet opens configured WordNet database, once,
+// nil if it isn't available
+func openWordNet() *wordnet.WordNet {
+	wordNetOnce.Do(func() {
+		if cfg.WordNet == "" {
+			return
+		}
+
+		wn, err := wordnet.Open(cfg.WordNet)
+		if err != nil {
+			if !os.IsNotExist(err) && err != wordnet.ErrNoWordNet {
+				log.Println("wordnet", err)
+			}
+			return
+		}
+		wordNet = wn
+	})
+
+	return wordNet
+}
+
+// wordNetGet gets word from local WordNet database,
+// if not found, suggestions are lemmas within
+// Levenshtein distance one
+func wordNetGet(word string) (*vocab.Word, []string, error) {
+	wn := openWordNet()
+	if wn == nil {
+		return nil, nil, errUnavailable
+	}
+
+	vw, err := wn.Lookup(word)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if vw != nil {
+		return vw, nil, nil
+	}
+
+	var sugs []string
+	lword := strings.ToLower(word)
+
+	wn.Words(func(w string) {
+		if dict.Levenshtein(lword, strings.ToLower(w)) <= 1 {
+			sugs = append(sugs, w)
+		}
+	})
+	sort.Strings(sugs)
+
+	return nil, sugs, nil
+}
+
+// wiktionaryGet gets word from imported wiktionary dump,
+// see `def import wiktionary`, if only it's capitalized
+// form is imported, that is suggested
+func wiktionaryGet(word string) (*vocab.Word, []string, error) {
+	source := wiktionarySource(langFlag)
+
+	for _, w := range []string{word, lang.Lower(langFlag, word)} {
+		vw, err := db.GetImported(source, w)
+		if err == nil {
+			return vw, nil, nil
+		} else if err != db.ErrKeyNotFound {
+			return nil, nil, err
+		}
+	}
+
+	if capitalWord := lang.Title(langFlag, word); db.HasImported(source, capitalWord) {
+		return nil, []string{capitalWord}, nil
+	}
+
+	return nil, nil, nil
+}
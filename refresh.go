@@ -0,0 +1,133 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/nilsocket/def/pkg/config"
+	"github.com/nilsocket/def/pkg/db"
+	"github.com/nilsocket/def/pkg/vocab"
+	"github.com/urfave/cli/v2"
+)
+
+var refreshOlderFlag string
+var refreshAllFlag bool
+
+// diffOut ,where refetch prints changes of words,
+// discarded by server
+var diffOut io.Writer = os.Stdout
+
+var refreshCmd = &cli.Command{
+	Name:      "refresh",
+	Usage:     "refetch stored words, and print what changed",
+	UsageText: "def refresh [options] [word ...]",
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "older-than", Usage: "refetch only words fetched before, ex: 180d, 12h", Destination: &refreshOlderFlag},
+		&cli.BoolFlag{Name: "all", Usage: "refetch all words, when none are given", Destination: &refreshAllFlag},
+	},
+	Action:       refreshAction,
+	BashComplete: completeWords,
+}
+
+// refreshAction refetches given words,
+// or all words in database, if none are given,
+// either --older-than or --all is required then
+func refreshAction(c *cli.Context) error {
+	words := c.Args().Slice()
+
+	if len(words) == 0 && refreshOlderFlag == "" && !refreshAllFlag {
+		return errors.New("refresh: give words, --older-than or --all")
+	}
+
+	var olderThan time.Duration
+	if refreshOlderFlag != "" {
+		var err error
+		if olderThan, err = config.ParseAge(refreshOlderFlag); err != nil {
+			return err
+		}
+	}
+
+	if len(words) == 0 {
+		db.Iterate(func(key string) {
+			words = append(words, key)
+		})
+	}
+
+	for _, word := range words {
+		vw, err := db.Get(word)
+		if err != nil {
+			log.Println(word, err)
+			continue
+		}
+
+		if !isStale(vw, olderThan) {
+			continue
+		}
+
+		refetch(vw)
+	}
+
+	return nil
+}
+
+// isStale reports whether vw is fetched before maxAge,
+// words whose fetch time is unknown are stale
+func isStale(vw *vocab.Word, maxAge time.Duration) bool {
+	return vw.Fetched.IsZero() || time.Since(vw.Fetched) > maxAge
+}
+
+// refetchIfStale refetches word from database,
+// if --refresh is given, or it's older than max_age,
+// returns refetched word if successful, otherwise vw,
+// either way word is in database
+func refetchIfStale(vw *vocab.Word) (*vocab.Word, bool) {
+	if !refreshFlag && (maxAge == 0 || !isStale(vw, maxAge)) {
+		return vw, true
+	}
+
+	if nw := refetch(vw); nw != nil {
+		return nw, true
+	}
+
+	return vw, true
+}
+
+// refetch word from it's provider, store it and print changes,
+// returns nil if word couldn't be fetched
+func refetch(vw *vocab.Word) *vocab.Word {
+	nw := refetchFromProvider(vw)
+	if nw == nil {
+		log.Println("refresh", vw.Word, "couldn't be fetched")
+		return nil
+	}
+
+	nw.CapitalOnly = vw.CapitalOnly
+
+	changes := vocab.Diff(vw, nw)
+	fmt.Fprint(diffOut, vocab.SprintDiff(vw.Word, changes))
+
+	// keep previous version, if it's different
+	if len(changes) != 0 {
+		if err := db.PutVersion(vw.Word, vw); err != nil {
+			log.Println("PutVersion", err)
+		}
+	}
+
+	if err := db.Put(nw.Word, nw); err != nil {
+		log.Println("Put", err)
+		return nw
+	}
+
+	// spelling changed, ex: `bharat` => `Bharat`
+	if nw.Word != vw.Word {
+		if err := db.Rename(vw.Word, nw.Word); err != nil {
+			log.Println("Rename", err)
+		}
+	}
+
+	return nw
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/chzyer/readline"
+	"github.com/nilsocket/def/pkg/db"
+)
+
+var historyFile = filepath.Join(homeDir, ".def_history")
+
+// replCmds are inline commands available in interactive mode,
+// toggles refer to flags of same name
+var replCmds = []string{":long", ":syn", ":ant", ":play", ":rm", ":help", ":quit"}
+
+var replHelp = `Type words to look them up, or one of:
+   :long        toggle long format
+   :syn         toggle synonyms
+   :ant         toggle antonyms
+   :play        toggle playing audio
+   :rm word ... remove words from database
+   :help        print this help
+   :quit        exit, same as Ctrl-D
+`
+
+// repl ,read eval print loop,
+// database is kept open until we exit
+func repl() error {
+	rl, err := readline.NewEx(&readline.Config{
+		Prompt:          "def> ",
+		HistoryFile:     historyFile,
+		AutoComplete:    replCompleter{},
+		InterruptPrompt: "^C",
+		EOFPrompt:       ":quit",
+	})
+	if err != nil {
+		return err
+	}
+	defer rl.Close()
+
+	for {
+		line, err := rl.Readline()
+		if err == readline.ErrInterrupt {
+			continue
+		} else if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if strings.HasPrefix(fields[0], ":") {
+			if quit := replCmd(fields[0], fields[1:]); quit {
+				return nil
+			}
+			continue
+		}
+
+		for _, word := range fields {
+			defWord(word)
+		}
+	}
+}
+
+// replCmd executes an inline command,
+// returns true if we have to quit
+func replCmd(cmd string, args []string) bool {
+	switch cmd {
+	case ":long":
+		longFlag = !longFlag
+		printToggle("long", longFlag)
+	case ":syn":
+		synFlag = !synFlag
+		printToggle("synonyms", synFlag)
+	case ":ant":
+		antFlag = !antFlag
+		printToggle("antonyms", antFlag)
+	case ":play":
+		playFlag = !playFlag
+		printToggle("play audio", playFlag)
+	case ":rm":
+		for _, word := range args {
+			if vw := storedWord(word); vw == nil {
+				fmt.Println("rm", word+":", "not in database")
+			} else if err := remove(word, vw); err != nil {
+				fmt.Println("rm", word+":", err)
+			}
+		}
+	case ":help":
+		fmt.Print(replHelp)
+	case ":quit", ":q":
+		return true
+	default:
+		fmt.Println("unknown command", cmd+", try :help")
+	}
+
+	return false
+}
+
+func printToggle(name string, on bool) {
+	if on {
+		fmt.Println(name, "on")
+	} else {
+		fmt.Println(name, "off")
+	}
+}
+
+// replCompleter completes inline commands,
+// and words stored in database
+type replCompleter struct{}
+
+// Do implements readline.AutoCompleter
+func (replCompleter) Do(line []rune, pos int) ([][]rune, int) {
+	// complete the word under cursor
+	start := pos
+	for start > 0 && line[start-1] != ' ' {
+		start--
+	}
+	prefix := string(line[start:pos])
+
+	var candidates []string
+	if start == 0 && strings.HasPrefix(prefix, ":") {
+		candidates = replCmds
+	} else {
+		db.Iterate(func(key string) {
+			candidates = append(candidates, key)
+		})
+	}
+
+	var newLine [][]rune
+	for _, c := range candidates {
+		if strings.HasPrefix(c, prefix) {
+			newLine = append(newLine, []rune(c[len(prefix):]+" "))
+		}
+	}
+
+	return newLine, len([]rune(prefix))
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/nilsocket/def/pkg/db"
+	"github.com/nilsocket/def/pkg/lang"
+	"github.com/nilsocket/def/pkg/lemma"
+	"github.com/nilsocket/def/pkg/text"
+	"github.com/nilsocket/def/pkg/vocab"
+	"github.com/urfave/cli/v2"
+)
+
+var scanCommonFlag, scanMinLenFlag int
+var scanNamesFlag bool
+
+var scanCmd = &cli.Command{
+	Name:      "scan",
+	Usage:     "find words worth learning in a text file, fetch them, and print a glossary",
+	UsageText: "def scan [options] file",
+	Flags: []cli.Flag{
+		&cli.IntFlag{Name: "common", Value: 0, Usage: "words among top `N` frequent words are skipped, 0 for whole bundled list of 10000", Destination: &scanCommonFlag},
+		&cli.IntFlag{Name: "min-length", Value: 4, Usage: "skip words shorter than it", Destination: &scanMinLenFlag},
+		&cli.BoolFlag{Name: "names", Usage: "include words which are always capitalized", Destination: &scanNamesFlag},
+	},
+	Action: scanAction,
+}
+
+func scanAction(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	f, err := os.Open(c.Args().First())
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	tokens, err := text.Tokenize(f)
+	if err != nil {
+		return err
+	}
+
+	stats := &batchStats{}
+	var glossary []*vocab.Word
+
+	for _, word := range hardWords(tokens) {
+		vw := batchWord(word, stats)
+		if vw != nil {
+			glossary = append(glossary, vw)
+		}
+	}
+
+	fmt.Printf("\n%d words: %d found, %d cached, %d suggested, %d failed\n\n",
+		stats.Found+stats.Cached+stats.Suggested+stats.Failed,
+		stats.Found, stats.Cached, stats.Suggested, stats.Failed)
+
+	sort.Slice(glossary, func(i, j int) bool {
+		return glossary[i].Word < glossary[j].Word
+	})
+
+	for _, vw := range glossary {
+		fmt.Println(vw.Word + " — " + vw.Gloss())
+	}
+
+	return nil
+}
+
+// hardWords returns lemmas of tokens,
+// which aren't common, short or names
+func hardWords(tokens []*text.Token) []string {
+	var words []string
+
+	for _, t := range tokens {
+		if len([]rune(t.Word)) < scanMinLenFlag || (t.Title && !scanNamesFlag) {
+			continue
+		}
+
+		// lemmatizer and common words are of english only
+		if langFlag != lang.Default {
+			words = append(words, t.Word)
+			continue
+		}
+
+		lem := lemma.Lemma(t.Word, knownWord)
+		if text.IsCommon(lem, scanCommonFlag) || text.IsCommon(t.Word, scanCommonFlag) {
+			continue
+		}
+
+		words = append(words, lem)
+	}
+
+	return dedup(words)
+}
+
+// knownWord reports whether word is a common word, or in database
+func knownWord(word string) bool {
+	return text.IsCommon(word, 0) || db.Has(word)
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/nilsocket/def/pkg/audio"
+	"github.com/nilsocket/def/pkg/db"
+	"github.com/urfave/cli/v2"
+)
+
+var serveAddrFlag string
+
+var serveCmd = &cli.Command{
+	Name:  "serve",
+	Usage: "serve definitions over http, as json",
+	Description: `Endpoints:
+   GET    /words                   list of stored words
+   GET    /words/{word}            definition, from database or internet
+   DELETE /words/{word}            remove word, or alias, from database
+   GET    /words/{word}/audio/{n}  n'th pronunciation, as audio/mpeg
+   GET    /suggestions/{word}      suggestions for a misspelled word
+   GET    /search?q={prefix}       stored words starting with prefix`,
+	Flags: []cli.Flag{
+		&cli.StringFlag{Name: "addr", Value: "localhost:8080", Usage: "address to listen on", Destination: &serveAddrFlag},
+	},
+	Action: serveAction,
+}
+
+// apiError ,body of error responses
+type apiError struct {
+	Error       string
+	Suggestions []string `json:",omitempty"`
+}
+
+func serveAction(c *cli.Context) error {
+	// words refreshed by requests aren't printed
+	diffOut = ioutil.Discard
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/words", listHandler)
+	mux.HandleFunc("/words/", wordHandler)
+	mux.HandleFunc("/suggestions/", suggestionsHandler)
+	mux.HandleFunc("/search", searchHandler)
+
+	log.Println("listening on", serveAddrFlag)
+	return http.ListenAndServe(serveAddrFlag, mux)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Println("serve", err)
+	}
+}
+
+func allowMethods(w http.ResponseWriter, r *http.Request, methods ...string) bool {
+	for _, m := range methods {
+		if r.Method == m {
+			return true
+		}
+	}
+
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	writeJSON(w, http.StatusMethodNotAllowed, apiError{Error: "method not allowed"})
+	return false
+}
+
+// listHandler ,GET /words
+func listHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+
+	words := []string{}
+	db.Iterate(func(key string) {
+		words = append(words, key)
+	})
+
+	writeJSON(w, http.StatusOK, words)
+}
+
+// wordHandler ,/words/{word} and /words/{word}/audio/{n}
+func wordHandler(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/words/")
+
+	if i := strings.Index(path, "/audio/"); i != -1 {
+		audioHandler(w, r, path[:i], path[i+len("/audio/"):])
+		return
+	}
+
+	word := path
+	if word == "" {
+		listHandler(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		vw, sugs, ldb, err := lookup(word)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, apiError{Error: err.Error()})
+			return
+		}
+
+		if vw == nil {
+			writeJSON(w, http.StatusNotFound, apiError{Error: "word not found", Suggestions: sugs})
+			return
+		}
+
+		if !ldb {
+			if err := db.Put(vw.Word, vw); err != nil {
+				log.Println("Put", err)
+			}
+		}
+
+		writeJSON(w, http.StatusOK, vw)
+	case http.MethodDelete:
+		// alias is removed, if word is an alias
+		vw := storedWord(word)
+		if vw == nil {
+			writeJSON(w, http.StatusNotFound, apiError{Error: "word not found"})
+			return
+		}
+
+		if err := remove(word, vw); errors.Is(err, errNotStored) {
+			writeJSON(w, http.StatusConflict, apiError{Error: err.Error()})
+			return
+		} else if err != nil {
+			writeJSON(w, http.StatusInternalServerError, apiError{Error: err.Error()})
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		allowMethods(w, r, http.MethodGet, http.MethodDelete)
+	}
+}
+
+// audioHandler ,GET /words/{word}/audio/{n}, n starts from 1
+func audioHandler(w http.ResponseWriter, r *http.Request, word, n string) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+
+	i, err := strconv.Atoi(n)
+	if err != nil || i < 1 {
+		writeJSON(w, http.StatusBadRequest, apiError{Error: "invalid audio number"})
+		return
+	}
+
+	vw, err := db.Get(word)
+	if err != nil {
+		writeJSON(w, http.StatusNotFound, apiError{Error: "word not found"})
+		return
+	}
+
+	if err := db.LoadAudios(vw); err != nil {
+		writeJSON(w, http.StatusInternalServerError, apiError{Error: err.Error()})
+		return
+	}
+
+	if i > len(vw.Audios) {
+		writeJSON(w, http.StatusNotFound, apiError{Error: "audio not found"})
+		return
+	}
+
+	a := vw.Audios[i-1]
+
+	contentType := "audio/mpeg"
+	if audio.IsWAV(a) {
+		contentType = "audio/wav"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(a)))
+	w.Write(a)
+}
+
+// suggestionsHandler ,GET /suggestions/{word}
+func suggestionsHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+
+	word := strings.TrimPrefix(r.URL.Path, "/suggestions/")
+
+	vw, sugs, ldb, err := lookup(word)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, apiError{Error: err.Error()})
+		return
+	}
+
+	// found words aren't misspelled, but keep them
+	if vw != nil && !ldb {
+		if err := db.Put(vw.Word, vw); err != nil {
+			log.Println("Put", err)
+		}
+	}
+
+	if sugs == nil {
+		sugs = []string{}
+	}
+
+	writeJSON(w, http.StatusOK, sugs)
+}
+
+// searchHandler ,GET /search?q={prefix}
+func searchHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+
+	prefix := strings.ToLower(r.URL.Query().Get("q"))
+
+	words := []string{}
+	db.Iterate(func(key string) {
+		if strings.HasPrefix(strings.ToLower(key), prefix) {
+			words = append(words, key)
+		}
+	})
+
+	writeJSON(w, http.StatusOK, words)
+}